@@ -0,0 +1,35 @@
+// Package constraints defines type sets to be used as generic constraints. It mirrors
+// golang.org/x/exp/constraints so that no external dependency is required.
+package constraints
+
+type (
+	// Signed is the set of signed integer types
+	Signed interface {
+		~int | ~int8 | ~int16 | ~int32 | ~int64
+	}
+
+	// Unsigned is the set of unsigned integer types
+	Unsigned interface {
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+	}
+
+	// Integer is the set of integer types
+	Integer interface {
+		Signed | Unsigned
+	}
+
+	// Float is the set of floating-point types
+	Float interface {
+		~float32 | ~float64
+	}
+
+	// Numeric is the set of types that support arithmetic operators
+	Numeric interface {
+		Integer | Float
+	}
+
+	// Ordered is the set of types that support the ordering operators < <= >= >
+	Ordered interface {
+		Integer | Float | ~string
+	}
+)
@@ -0,0 +1,33 @@
+package constraints
+
+import (
+	"testing"
+	"time"
+)
+
+func signed[T Signed](x T) T         { return x }
+func unsigned[T Unsigned](x T) T     { return x }
+func integer[T Integer](x T) T       { return x }
+func float[T Float](x T) T           { return x }
+func numeric[T Numeric](x T) T       { return x + x }
+func ordered[T Ordered](x, y T) bool { return x < y }
+
+func TestConstraints(t *testing.T) {
+	_ = signed(int8(1))
+	_ = signed(int64(1))
+	_ = signed(time.Second)
+	_ = unsigned(uint8(1))
+	_ = unsigned(uintptr(1))
+	_ = integer(1)
+	_ = integer(uint32(1))
+	_ = float(float32(1))
+	_ = float(1.0)
+
+	if numeric(2) != 4 || numeric(1.5) != 3 || numeric(uint16(3)) != 6 {
+		t.Errorf("unexpected arithmetic on numeric constraint")
+	}
+
+	if !ordered("a", "b") || !ordered(1, 2) || !ordered(1.5, 2.5) {
+		t.Errorf("unexpected ordering on ordered constraint")
+	}
+}
@@ -0,0 +1,57 @@
+package _map
+
+type (
+	// BiMap keeps a one-to-one association between keys and values, allowing lookups in
+	// both directions.
+	BiMap[K, V comparable] struct {
+		forward  map[K]V
+		backward map[V]K
+	}
+)
+
+func NewBiMap[K, V comparable]() BiMap[K, V] {
+	return BiMap[K, V]{
+		forward:  make(map[K]V),
+		backward: make(map[V]K),
+	}
+}
+
+// Set associates `k` with `v`. To keep the mapping one-to-one, the last write wins: any
+// value previously held by `k` and any key previously holding `v` are evicted.
+func (m BiMap[K, V]) Set(k K, v V) {
+	if old, ok := m.forward[k]; ok {
+		delete(m.backward, old)
+	}
+
+	if old, ok := m.backward[v]; ok {
+		delete(m.forward, old)
+	}
+
+	m.forward[k] = v
+	m.backward[v] = k
+}
+
+func (m BiMap[K, V]) GetByKey(k K) (v V, ok bool) {
+	v, ok = m.forward[k]
+	return
+}
+
+func (m BiMap[K, V]) GetByValue(v V) (k K, ok bool) {
+	k, ok = m.backward[v]
+	return
+}
+
+func (m BiMap[K, V]) DeleteByKey(k K) (v V, ok bool) {
+	v, ok = m.forward[k]
+	if !ok {
+		return
+	}
+
+	delete(m.forward, k)
+	delete(m.backward, v)
+	return
+}
+
+func (m BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
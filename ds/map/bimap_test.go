@@ -0,0 +1,56 @@
+package _map
+
+import "testing"
+
+func TestBiMap(t *testing.T) {
+	m := NewBiMap[int, string]()
+
+	m.Set(1, "one")
+	m.Set(2, "two")
+
+	if v, ok := m.GetByKey(1); !ok || v != "one" {
+		t.Errorf("unexpected value, want (one, true), have (%s, %t)", v, ok)
+	}
+
+	if k, ok := m.GetByValue("two"); !ok || k != 2 {
+		t.Errorf("unexpected key, want (2, true), have (%d, %t)", k, ok)
+	}
+
+	// Rebinding a key evicts its former value.
+	m.Set(1, "uno")
+
+	if _, ok := m.GetByValue("one"); ok {
+		t.Error("unexpected value, want evicted, have present")
+	}
+
+	// Rebinding a value evicts its former key.
+	m.Set(3, "two")
+
+	if _, ok := m.GetByKey(2); ok {
+		t.Error("unexpected key, want evicted, have present")
+	}
+
+	if k, ok := m.GetByValue("two"); !ok || k != 3 {
+		t.Errorf("unexpected key, want (3, true), have (%d, %t)", k, ok)
+	}
+
+	if m.Len() != 2 {
+		t.Errorf("unexpected length, want 2, have %d", m.Len())
+	}
+
+	if v, ok := m.DeleteByKey(3); !ok || v != "two" {
+		t.Errorf("unexpected deleted value, want (two, true), have (%s, %t)", v, ok)
+	}
+
+	if _, ok := m.GetByValue("two"); ok {
+		t.Error("unexpected value, want deleted, have present")
+	}
+
+	if _, ok := m.DeleteByKey(3); ok {
+		t.Error("unexpected deletion, want noop, have deleted")
+	}
+
+	if m.Len() != 1 {
+		t.Errorf("unexpected length, want 1, have %d", m.Len())
+	}
+}
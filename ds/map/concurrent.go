@@ -1,6 +1,7 @@
 package _map
 
 import (
+	"iter"
 	"sync"
 
 	"github.com/sonirico/stadio/fp"
@@ -21,16 +22,39 @@ func NewConcurrent[K comparable, V any](inner Map[K, V]) *Concurrent[K, V] {
 	return &Concurrent[K, V]{MapInner: inner}
 }
 
-func (m *Concurrent[K, V]) Get(k K) (v V, ok bool) {
+// NewConcurrentFromMap builds a Concurrent map backed by a Native one seeded with a copy of `m`.
+func NewConcurrentFromMap[K comparable, V any](m map[K]V) *Concurrent[K, V] {
+	return NewConcurrent[K, V](FromMap(m))
+}
+
+// lockLookup takes the lock guarding Get and GetOption: the read lock, unless the inner map
+// writes on lookups, as Default does.
+func (m *Concurrent[K, V]) lockLookup() (unlock func()) {
+	if _, ok := m.MapInner.(writingLookups); ok {
+		m.L.Lock()
+		return m.L.Unlock
+	}
 	m.L.RLock()
+	return m.L.RUnlock
+}
+
+func (m *Concurrent[K, V]) Get(k K) (v V, ok bool) {
+	unlock := m.lockLookup()
 	v, ok = m.MapInner.Get(k)
-	m.L.RUnlock()
+	unlock()
+	return
+}
+
+func (m *Concurrent[K, V]) GetOption(k K) (v fp.Option[V]) {
+	unlock := m.lockLookup()
+	v = m.MapInner.GetOption(k)
+	unlock()
 	return
 }
 
 func (m *Concurrent[K, V]) Has(k K) (ok bool) {
 	m.L.RLock()
-	_, ok = m.MapInner.Get(k)
+	ok = m.MapInner.Has(k)
 	m.L.RUnlock()
 	return
 }
@@ -48,6 +72,16 @@ func (m *Concurrent[K, V]) Range(fn func(K, V, int) bool) {
 	m.MapInner.Range(fn)
 }
 
+// ForEach calls `fn` for every entry, stopping at and returning the first error. The read lock
+// is held for the whole iteration, hence `fn` must not access the map at all: writes deadlock
+// right away, and reads such as Get or Has deadlock as soon as a writer is waiting, since read
+// locks cannot be nested. Iterate over Snapshot to access the map.
+func (m *Concurrent[K, V]) ForEach(fn func(K, V) error) error {
+	m.L.RLock()
+	defer m.L.RUnlock()
+	return m.MapInner.ForEach(fn)
+}
+
 func (m *Concurrent[K, V]) Delete(k K) {
 	m.L.Lock()
 	m.MapInner.Delete(k)
@@ -61,6 +95,53 @@ func (m *Concurrent[K, V]) GetOrSet(k K, def V) (v V, ok bool) {
 	return
 }
 
+// SetIfAbsent atomically stores `v` under `k` only if the key is missing, returning whether it
+// was stored. Among concurrent callers on the same key, exactly one succeeds.
+func (m *Concurrent[K, V]) SetIfAbsent(k K, v V) (ok bool) {
+	m.L.Lock()
+	ok = m.MapInner.SetIfAbsent(k, v)
+	m.L.Unlock()
+	return
+}
+
+// CompareAndSwap atomically stores `value` under `k` only if the current value is equal to
+// `old` according to `eq`, returning whether the swap happened. Absent keys are never swapped.
+func (m *Concurrent[K, V]) CompareAndSwap(k K, old, value V, eq func(V, V) bool) (swapped bool) {
+	m.L.Lock()
+	defer m.L.Unlock()
+
+	if !m.MapInner.Has(k) {
+		return false
+	}
+
+	current, _ := m.MapInner.Get(k)
+	if !eq(current, old) {
+		return false
+	}
+
+	m.MapInner.Set(k, value)
+	return true
+}
+
+// CompareAndDelete atomically deletes `k` only if its current value is equal to `old`
+// according to `eq`, returning whether the deletion happened.
+func (m *Concurrent[K, V]) CompareAndDelete(k K, old V, eq func(V, V) bool) (deleted bool) {
+	m.L.Lock()
+	defer m.L.Unlock()
+
+	if !m.MapInner.Has(k) {
+		return false
+	}
+
+	current, _ := m.MapInner.Get(k)
+	if !eq(current, old) {
+		return false
+	}
+
+	m.MapInner.Delete(k)
+	return true
+}
+
 func (m *Concurrent[K, V]) Map(fn func(K, V) (K, V)) Map[K, V] {
 	m.L.RLock()
 	defer m.L.RUnlock()
@@ -95,9 +176,77 @@ func (m *Concurrent[K, V]) Keys() slices.Slice[K] {
 	return res
 }
 
+// KeysAndValues returns all keys and values taken under a single read lock, so that both are
+// consistent with each other: values[i] is the value stored under keys[i]. Calling Keys and
+// Values separately offers no such guarantee under concurrent writes.
+func (m *Concurrent[K, V]) KeysAndValues() (slices.Slice[K], slices.Slice[V]) {
+	m.L.RLock()
+	defer m.L.RUnlock()
+
+	entries := m.MapInner.Entries()
+	keys := make([]K, len(entries))
+	values := make([]V, len(entries))
+
+	for i, e := range entries {
+		keys[i] = e.K
+		values[i] = e.V
+	}
+
+	return keys, values
+}
+
 func (m *Concurrent[K, V]) Entries() slices.Slice[Entry[K, V]] {
 	m.L.RLock()
 	res := m.MapInner.Entries()
 	m.L.RUnlock()
 	return res
 }
+
+func (m *Concurrent[K, V]) ValuesInto(dst []V) []V {
+	m.L.RLock()
+	dst = m.MapInner.ValuesInto(dst)
+	m.L.RUnlock()
+	return dst
+}
+
+func (m *Concurrent[K, V]) KeysInto(dst []K) []K {
+	m.L.RLock()
+	dst = m.MapInner.KeysInto(dst)
+	m.L.RUnlock()
+	return dst
+}
+
+func (m *Concurrent[K, V]) EntriesInto(dst []Entry[K, V]) []Entry[K, V] {
+	m.L.RLock()
+	dst = m.MapInner.EntriesInto(dst)
+	m.L.RUnlock()
+	return dst
+}
+
+func (m *Concurrent[K, V]) AsMap() map[K]V {
+	m.L.RLock()
+	res := m.MapInner.AsMap()
+	m.L.RUnlock()
+	return res
+}
+
+// Snapshot returns a consistent copy of all entries, taken under a single read lock.
+func (m *Concurrent[K, V]) Snapshot() map[K]V {
+	return m.AsMap()
+}
+
+// Iter returns an iterator over all entries. The read lock is held for the whole iteration,
+// hence writers are blocked until the loop finishes and the loop body must not access the map
+// at all: writes deadlock right away, and reads such as Get or Has deadlock as soon as a writer
+// is waiting, since read locks cannot be nested. Iterate over Snapshot to access the map.
+func (m *Concurrent[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.L.RLock()
+		defer m.L.RUnlock()
+		for k, v := range m.MapInner.Iter() {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
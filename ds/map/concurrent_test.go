@@ -0,0 +1,268 @@
+package _map
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestConcurrent_Snapshot(t *testing.T) {
+	m := NewConcurrent[int, int](NewNative[int, int]())
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				m.Set(w*100+i, i)
+			}
+		}(w)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			snapshot := m.Snapshot()
+			for k, v := range snapshot {
+				if k%100 != v {
+					t.Errorf("unexpected snapshot entry, have (%d, %d)", k, v)
+				}
+			}
+		}
+	}()
+
+	wg.Wait()
+
+	snapshot := m.Snapshot()
+	if len(snapshot) != 400 {
+		t.Errorf("unexpected snapshot length, want %d, have %d", 400, len(snapshot))
+	}
+
+	snapshot[-1] = -1
+	if m.Has(-1) {
+		t.Errorf("unexpected mutation of map through its snapshot")
+	}
+}
+
+func TestNewConcurrentFromMap(t *testing.T) {
+	payload := map[string]int{"a": 1}
+
+	m := NewConcurrentFromMap(payload)
+	payload["b"] = 2
+
+	if v, ok := m.Get("a"); !ok || v != 1 {
+		t.Errorf("unexpected value, want (1, true), have (%d, %t)", v, ok)
+	}
+
+	if m.Has("b") {
+		t.Errorf("unexpected entry, source map mutations should not be visible")
+	}
+}
+
+func TestConcurrent_Iter(t *testing.T) {
+	m := NewConcurrentFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	sum := 0
+	for _, v := range m.Iter() {
+		sum += v
+	}
+
+	if sum != 6 {
+		t.Errorf("unexpected sum, want %d, have %d", 6, sum)
+	}
+
+	visited := 0
+	for range m.Iter() {
+		visited++
+		break
+	}
+
+	if visited != 1 {
+		t.Errorf("unexpected visited entries, want %d, have %d", 1, visited)
+	}
+
+	// lock must be released after breaking out of the loop
+	m.Set("d", 4)
+}
+
+func TestConcurrent_ForEach(t *testing.T) {
+	m := NewConcurrentFromMap(map[int]int{1: 1, 2: 2, 3: 3, 4: 4, 5: 5})
+	fail := errors.New("third entry")
+
+	calls := 0
+	err := m.ForEach(func(k, v int) error {
+		calls++
+		if calls == 3 {
+			return fail
+		}
+		return nil
+	})
+
+	if !errors.Is(err, fail) {
+		t.Errorf("unexpected error, want %v, have %v", fail, err)
+	}
+
+	if calls != 3 {
+		t.Errorf("unexpected calls, want %d, have %d", 3, calls)
+	}
+
+	// lock must be released after an error
+	m.Set(6, 6)
+}
+
+func TestConcurrent_CompareAndSwap(t *testing.T) {
+	m := NewConcurrentFromMap(map[string]int{"counter": 0})
+	eq := func(x, y int) bool { return x == y }
+
+	if m.CompareAndSwap("missing", 0, 1, eq) {
+		t.Errorf("unexpected swap on absent key")
+	}
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				for {
+					current, _ := m.Get("counter")
+					if m.CompareAndSwap("counter", current, current+1, eq) {
+						break
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if v, _ := m.Get("counter"); v != 800 {
+		t.Errorf("unexpected counter, want %d, have %d", 800, v)
+	}
+}
+
+func TestConcurrent_CompareAndDelete(t *testing.T) {
+	m := NewConcurrentFromMap(map[string]int{"a": 1})
+	eq := func(x, y int) bool { return x == y }
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		deleted int
+	)
+
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if m.CompareAndDelete("a", 1, eq) {
+				mu.Lock()
+				deleted++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if deleted != 1 {
+		t.Errorf("unexpected deletions, want %d, have %d", 1, deleted)
+	}
+
+	m.Set("b", 2)
+	if m.CompareAndDelete("b", 3, eq) || !m.Has("b") {
+		t.Errorf("unexpected deletion with mismatching value")
+	}
+}
+
+func TestConcurrent_GetOption(t *testing.T) {
+	m := NewConcurrentFromMap(map[string]int{"a": 1})
+
+	if v := m.GetOption("a").Map(func(x int) int { return x * 2 }).UnwrapOr(0); v != 2 {
+		t.Errorf("unexpected value, want %d, have %d", 2, v)
+	}
+
+	if m.GetOption("b").IsSome() {
+		t.Errorf("unexpected value, want none for absent key")
+	}
+}
+
+func TestConcurrent_SetIfAbsent(t *testing.T) {
+	m := NewConcurrentFromMap(map[string]int{"a": 1})
+
+	if m.SetIfAbsent("a", 2) {
+		t.Errorf("unexpected store, want noop for present key")
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		stored int
+	)
+
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			if m.SetIfAbsent("b", w) {
+				mu.Lock()
+				stored++
+				mu.Unlock()
+			}
+		}(w)
+	}
+
+	wg.Wait()
+
+	if stored != 1 || !m.Has("b") {
+		t.Errorf("unexpected stores for absent key, want 1, have %d", stored)
+	}
+}
+
+func TestConcurrent_KeysAndValues(t *testing.T) {
+	m := NewConcurrent[int, int](NewNative[int, int]())
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				m.Set(w*1000+i, -(w*1000 + i))
+				if i%3 == 0 {
+					m.Delete(w*1000 + i - 1)
+				}
+			}
+		}(w)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			keys, values := m.KeysAndValues()
+			if len(keys) != len(values) {
+				t.Errorf("unexpected lengths, want equal, have %d and %d", len(keys), len(values))
+				return
+			}
+			for j := range keys {
+				if values[j] != -keys[j] {
+					t.Errorf("unexpected misaligned entry, have (%d, %d)", keys[j], values[j])
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Wait()
+
+	keys, values := m.KeysAndValues()
+	if len(keys) != m.Keys().Len() || len(values) != len(keys) {
+		t.Errorf("unexpected lengths, have %d keys and %d values", len(keys), len(values))
+	}
+}
@@ -0,0 +1,55 @@
+package _map
+
+import "sync"
+
+type (
+	// Counter is a thread-safe tally of occurrences per key.
+	Counter[K comparable] struct {
+		L    sync.RWMutex
+		data map[K]int64
+	}
+)
+
+func NewCounter[K comparable]() *Counter[K] {
+	return &Counter[K]{data: make(map[K]int64)}
+}
+
+// Inc increments the count of `k` by one, returning the new count.
+func (c *Counter[K]) Inc(k K) int64 {
+	return c.Add(k, 1)
+}
+
+// Add increments the count of `k` by `n`, which may be negative, returning the new count.
+func (c *Counter[K]) Add(k K, n int64) int64 {
+	c.L.Lock()
+	c.data[k] += n
+	res := c.data[k]
+	c.L.Unlock()
+	return res
+}
+
+// Get returns the count of `k`, 0 if it was never incremented.
+func (c *Counter[K]) Get(k K) int64 {
+	c.L.RLock()
+	res := c.data[k]
+	c.L.RUnlock()
+	return res
+}
+
+func (c *Counter[K]) Len() int {
+	c.L.RLock()
+	res := len(c.data)
+	c.L.RUnlock()
+	return res
+}
+
+// Snapshot returns a copy of all counts at a single point in time.
+func (c *Counter[K]) Snapshot() map[K]int64 {
+	c.L.RLock()
+	res := make(map[K]int64, len(c.data))
+	for k, v := range c.data {
+		res[k] = v
+	}
+	c.L.RUnlock()
+	return res
+}
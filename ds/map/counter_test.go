@@ -0,0 +1,41 @@
+package _map
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestCounter(t *testing.T) {
+	c := NewCounter[string]()
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				c.Inc("hits")
+				c.Add("bytes", 2)
+				_ = c.Get("hits")
+				_ = c.Snapshot()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if hits := c.Get("hits"); hits != 8000 {
+		t.Errorf("unexpected count, want 8000, have %d", hits)
+	}
+
+	snapshot := c.Snapshot()
+	if len(snapshot) != 2 || snapshot["bytes"] != 16000 {
+		t.Errorf("unexpected snapshot, want map[bytes:16000 hits:8000], have %v", snapshot)
+	}
+
+	snapshot["hits"] = 0
+	if c.Get("hits") != 8000 || c.Get("misses") != 0 || c.Len() != 2 {
+		t.Errorf("unexpected counter state after snapshot mutation")
+	}
+}
@@ -0,0 +1,43 @@
+package _map
+
+import "github.com/sonirico/stadio/fp"
+
+type (
+	// Default is a Native map that, much like Python's defaultdict, initializes missing keys
+	// upon lookup with the value built by its factory.
+	Default[K comparable, V any] struct {
+		Native[K, V]
+		factory func() V
+	}
+
+	// writingLookups is implemented by maps whose Get and GetOption may write, so that
+	// Concurrent guards them with the write lock.
+	writingLookups interface {
+		writingLookups()
+	}
+)
+
+func NewDefault[K comparable, V any](factory func() V) Default[K, V] {
+	return Default[K, V]{Native: NewNative[K, V](), factory: factory}
+}
+
+// Get always succeeds: missing keys are set to a fresh value from the factory, which is then
+// returned along with true. Use Has to check for presence without inserting. When wrapped by
+// Concurrent, lookups take the write lock.
+func (m Default[K, V]) Get(k K) (V, bool) {
+	if v, ok := m.data[k]; ok {
+		return v, true
+	}
+
+	v := m.factory()
+	m.data[k] = v
+	return v, true
+}
+
+// GetOption always returns Some, initializing missing keys like Get does.
+func (m Default[K, V]) GetOption(k K) fp.Option[V] {
+	v, _ := m.Get(k)
+	return fp.Some(v)
+}
+
+func (m Default[K, V]) writingLookups() {}
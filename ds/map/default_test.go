@@ -0,0 +1,100 @@
+package _map
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestDefault(t *testing.T) {
+	calls := 0
+	m := NewDefault[string, []int](func() []int {
+		calls++
+		return make([]int, 0, 2)
+	})
+
+	if m.Has("a") {
+		t.Errorf("unexpected entry, Has should not initialize missing keys")
+	}
+
+	values, ok := m.Get("a")
+	if !ok || values == nil || len(values) != 0 {
+		t.Errorf("unexpected value, want ([], true), have (%v, %t)", values, ok)
+	}
+
+	m.Set("a", append(values, 1))
+
+	if values, _ := m.Get("a"); len(values) != 1 || values[0] != 1 {
+		t.Errorf("unexpected value, want [1], have %v", values)
+	}
+
+	if calls != 1 {
+		t.Errorf("unexpected factory calls, want 1, have %d", calls)
+	}
+
+	if values := m.GetOption("b").UnwrapUnsafe(); len(values) != 0 || !m.Has("b") {
+		t.Errorf("unexpected value, want stored [], have %v", values)
+	}
+
+	if calls != 2 || len(m.Keys()) != 2 {
+		t.Errorf("unexpected state, want 2 calls and 2 keys, have %d and %d", calls, len(m.Keys()))
+	}
+
+	var _ Map[string, []int] = m
+}
+
+func TestDefault_Concurrent(t *testing.T) {
+	m := NewConcurrent[int, int](NewDefault[int, int](func() int { return 7 }))
+
+	if m.Has(1) || len(m.Keys()) != 0 {
+		t.Errorf("unexpected entry, Has should not initialize missing keys")
+	}
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				if v, ok := m.Get(i % 10); !ok || v != 7 {
+					t.Errorf("unexpected value, want (7, true), have (%d, %t)", v, ok)
+				}
+				_ = m.GetOption(i % 20)
+				_ = m.Has(i % 30)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if n := len(m.Keys()); n != 20 {
+		t.Errorf("unexpected keys, want 20, have %d", n)
+	}
+}
+
+func TestDefault_ConcurrentCompareAndSwap(t *testing.T) {
+	m := NewConcurrent[string, int](NewDefault[string, int](func() int { return 0 }))
+	eq := func(x, y int) bool { return x == y }
+
+	if m.CompareAndSwap("missing", 0, 5, eq) {
+		t.Errorf("unexpected swap, absent keys should never be swapped")
+	}
+
+	if m.CompareAndDelete("other", 0, eq) {
+		t.Errorf("unexpected deletion, absent keys should never be deleted")
+	}
+
+	if n := len(m.Keys()); n != 0 {
+		t.Errorf("unexpected keys, want 0, have %d", n)
+	}
+
+	m.Set("present", 1)
+
+	if !m.CompareAndSwap("present", 1, 2, eq) {
+		t.Errorf("unexpected noop, want swap for matching value")
+	}
+
+	if !m.CompareAndDelete("present", 2, eq) || m.Has("present") {
+		t.Errorf("unexpected noop, want deletion for matching value")
+	}
+}
@@ -1,6 +1,8 @@
 package _map
 
 import (
+	"iter"
+
 	"github.com/sonirico/stadio/fp"
 	"github.com/sonirico/stadio/slices"
 	"github.com/sonirico/stadio/tuples"
@@ -9,16 +11,44 @@ import (
 type (
 	Map[K comparable, V any] interface {
 		Get(K) (V, bool)
+		GetOption(K) fp.Option[V]
 		Has(K) bool
 		Set(K, V)
 		Range(fn func(K, V, int) bool)
+		ForEach(fn func(K, V) error) error
 		Delete(K)
 		GetOrSet(K, V) (V, bool)
+		SetIfAbsent(K, V) bool
 		Map(fn func(K, V) (K, V)) Map[K, V]
 		FilterMap(fn func(K, V) fp.Option[tuples.Tuple2[K, V]]) Map[K, V]
 		Filter(fn func(K, V) bool) Map[K, V]
 		Keys() slices.Slice[K]
 		Values() slices.Slice[V]
 		Entries() slices.Slice[Entry[K, V]]
+		KeysInto(dst []K) []K
+		ValuesInto(dst []V) []V
+		EntriesInto(dst []Entry[K, V]) []Entry[K, V]
+		AsMap() map[K]V
+		Iter() iter.Seq2[K, V]
 	}
 )
+
+// GroupBy groups the elements of the slice by the key derived from `keyFn` into a Native map,
+// preserving their order within each group. It lives here rather than in the slices package,
+// which this one depends on.
+func GroupBy[T any, K comparable](arr []T, keyFn func(T) K) Map[K, []T] {
+	return Native[K, []T]{data: slices.GroupBy(arr, keyFn)}
+}
+
+// Union returns a new Native map holding the entries of both maps, which are left untouched.
+// On conflicting keys, the value from `b` wins.
+func Union[K comparable, V any](a, b Map[K, V]) Map[K, V] {
+	res := NewNative[K, V]()
+	for _, m := range []Map[K, V]{a, b} {
+		m.Range(func(k K, v V, _ int) bool {
+			res.data[k] = v
+			return true
+		})
+	}
+	return res
+}
@@ -0,0 +1,56 @@
+package _map
+
+import (
+	"testing"
+)
+
+func TestUnion(t *testing.T) {
+	a := FromMap(map[string]int{"a": 1, "b": 2})
+	b := NewConcurrentFromMap(map[string]int{"b": 20, "c": 30})
+
+	res := Union[string, int](a, b)
+
+	expected := map[string]int{"a": 1, "b": 20, "c": 30}
+	actual := res.AsMap()
+
+	if len(actual) != len(expected) {
+		t.Fatalf("unexpected map, want %v, have %v", expected, actual)
+	}
+
+	for k, v := range expected {
+		if actual[k] != v {
+			t.Errorf("unexpected value for %s, want %d, have %d", k, v, actual[k])
+		}
+	}
+
+	if v, _ := a.Get("b"); v != 2 || a.Has("c") {
+		t.Errorf("unexpected mutation of left operand, have %v", a.AsMap())
+	}
+
+	if v, _ := b.Get("b"); v != 20 || b.Has("a") {
+		t.Errorf("unexpected mutation of right operand, have %v", b.AsMap())
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	m := GroupBy([]int{1, 2, 3, 4, 5}, func(x int) bool { return x%2 == 0 })
+
+	total := 0
+	m.Range(func(even bool, values []int, _ int) bool {
+		for _, x := range values {
+			if (x%2 == 0) != even {
+				t.Errorf("unexpected value %d grouped under %t", x, even)
+			}
+		}
+		total += len(values)
+		return true
+	})
+
+	if total != 5 {
+		t.Errorf("unexpected grouped values, want %d, have %d", 5, total)
+	}
+
+	if odds, _ := m.Get(false); len(odds) != 3 || odds[0] != 1 || odds[2] != 5 {
+		t.Errorf("unexpected group, want [1 3 5], have %v", odds)
+	}
+}
@@ -0,0 +1,110 @@
+package _map
+
+import (
+	"sync"
+
+	"github.com/sonirico/stadio/slices"
+)
+
+type (
+	// MultiMap associates each key with a list of values, kept in insertion order.
+	MultiMap[K comparable, V any] struct {
+		data map[K][]V
+	}
+
+	ConcurrentMultiMap[K comparable, V any] struct {
+		L     sync.RWMutex
+		inner MultiMap[K, V]
+	}
+)
+
+func NewMultiMap[K comparable, V any]() MultiMap[K, V] {
+	return MultiMap[K, V]{data: make(map[K][]V)}
+}
+
+func (m MultiMap[K, V]) Add(k K, v V) {
+	m.data[k] = append(m.data[k], v)
+}
+
+// Get returns the values stored under `k`, sharing memory with the MultiMap.
+func (m MultiMap[K, V]) Get(k K) []V {
+	return m.data[k]
+}
+
+func (m MultiMap[K, V]) Has(k K) (ok bool) {
+	_, ok = m.data[k]
+	return
+}
+
+func (m MultiMap[K, V]) Delete(k K) {
+	delete(m.data, k)
+}
+
+func (m MultiMap[K, V]) Count(k K) int {
+	return len(m.data[k])
+}
+
+func (m MultiMap[K, V]) Len() int {
+	return len(m.data)
+}
+
+func (m MultiMap[K, V]) Range(fn func(K, []V, int) bool) {
+	i := 0
+	for k, v := range m.data {
+		if !fn(k, v, i) {
+			return
+		}
+		i++
+	}
+}
+
+func NewConcurrentMultiMap[K comparable, V any]() *ConcurrentMultiMap[K, V] {
+	return &ConcurrentMultiMap[K, V]{inner: NewMultiMap[K, V]()}
+}
+
+func (m *ConcurrentMultiMap[K, V]) Add(k K, v V) {
+	m.L.Lock()
+	m.inner.Add(k, v)
+	m.L.Unlock()
+}
+
+// Get returns a copy of the values stored under `k`.
+func (m *ConcurrentMultiMap[K, V]) Get(k K) []V {
+	m.L.RLock()
+	res := slices.Clone(m.inner.Get(k))
+	m.L.RUnlock()
+	return res
+}
+
+func (m *ConcurrentMultiMap[K, V]) Has(k K) (ok bool) {
+	m.L.RLock()
+	ok = m.inner.Has(k)
+	m.L.RUnlock()
+	return
+}
+
+func (m *ConcurrentMultiMap[K, V]) Delete(k K) {
+	m.L.Lock()
+	m.inner.Delete(k)
+	m.L.Unlock()
+}
+
+func (m *ConcurrentMultiMap[K, V]) Count(k K) (n int) {
+	m.L.RLock()
+	n = m.inner.Count(k)
+	m.L.RUnlock()
+	return
+}
+
+func (m *ConcurrentMultiMap[K, V]) Len() (n int) {
+	m.L.RLock()
+	n = m.inner.Len()
+	m.L.RUnlock()
+	return
+}
+
+func (m *ConcurrentMultiMap[K, V]) Range(fn func(K, []V, int) bool) {
+	m.L.RLock()
+	defer m.L.RUnlock()
+	m.inner.Range(fn)
+}
@@ -0,0 +1,67 @@
+package _map
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestMultiMap(t *testing.T) {
+	m := NewMultiMap[string, int]()
+
+	m.Add("a", 1)
+	m.Add("a", 2)
+	m.Add("b", 3)
+
+	if values := m.Get("a"); len(values) != 2 || values[0] != 1 || values[1] != 2 {
+		t.Errorf("unexpected values, want [1 2], have %v", values)
+	}
+
+	if m.Count("a") != 2 || m.Count("b") != 1 || m.Count("c") != 0 {
+		t.Errorf("unexpected counts, have a=%d b=%d c=%d", m.Count("a"), m.Count("b"), m.Count("c"))
+	}
+
+	total := 0
+	m.Range(func(_ string, values []int, _ int) bool {
+		total += len(values)
+		return true
+	})
+
+	if total != 3 {
+		t.Errorf("unexpected total values, want 3, have %d", total)
+	}
+
+	m.Delete("a")
+
+	if m.Has("a") || m.Get("a") != nil || m.Len() != 1 {
+		t.Errorf("unexpected entry after deletion, have %v", m.Get("a"))
+	}
+}
+
+func TestConcurrentMultiMap(t *testing.T) {
+	m := NewConcurrentMultiMap[int, int]()
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				m.Add(i%10, i)
+				_ = m.Get(i % 10)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if m.Len() != 10 || m.Count(0) != 40 {
+		t.Errorf("unexpected sizes, want (10, 40), have (%d, %d)", m.Len(), m.Count(0))
+	}
+
+	m.Delete(0)
+
+	if m.Has(0) {
+		t.Errorf("unexpected entry after deletion")
+	}
+}
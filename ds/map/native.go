@@ -1,6 +1,8 @@
 package _map
 
 import (
+	"iter"
+
 	"github.com/sonirico/stadio/fp"
 	"github.com/sonirico/stadio/maps"
 	"github.com/sonirico/stadio/slices"
@@ -22,11 +24,28 @@ func NewNative[K comparable, V any]() Native[K, V] {
 	return Native[K, V]{data: make(map[K]V)}
 }
 
+// FromMap builds a Native map seeded with the entries of `m`. The given map is copied, so
+// further mutations on it are not reflected on the result and vice versa.
+func FromMap[K comparable, V any](m map[K]V) Native[K, V] {
+	res := Native[K, V]{data: make(map[K]V, len(m))}
+	for k, v := range m {
+		res.data[k] = v
+	}
+	return res
+}
+
 func (m Native[K, V]) Get(k K) (v V, ok bool) {
 	v, ok = m.data[k]
 	return
 }
 
+func (m Native[K, V]) GetOption(k K) fp.Option[V] {
+	if v, ok := m.data[k]; ok {
+		return fp.Some(v)
+	}
+	return fp.None[V]()
+}
+
 func (m Native[K, V]) Has(k K) (ok bool) {
 	_, ok = m.data[k]
 	return
@@ -47,6 +66,16 @@ func (m Native[K, V]) Range(fn func(K, V, int) bool) {
 	}
 }
 
+// ForEach calls `fn` for every entry, stopping at and returning the first error.
+func (m Native[K, V]) ForEach(fn func(K, V) error) error {
+	for k, v := range m.data {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m Native[K, V]) Delete(k K) {
 	delete(m.data, k)
 }
@@ -62,6 +91,16 @@ func (m Native[K, V]) GetOrSet(k K, def V) (v V, ok bool) {
 	return
 }
 
+// SetIfAbsent stores `v` under `k` only if the key is missing, returning whether it was stored.
+func (m Native[K, V]) SetIfAbsent(k K, v V) bool {
+	if _, ok := m.data[k]; ok {
+		return false
+	}
+
+	m.data[k] = v
+	return true
+}
+
 func (m Native[K, V]) Map(fn func(K, V) (K, V)) Map[K, V] {
 	return Native[K, V]{data: maps.Map(m.data, fn)}
 }
@@ -103,3 +142,50 @@ func (m Native[K, V]) Entries() slices.Slice[Entry[K, V]] {
 	}
 	return res
 }
+
+// ValuesInto appends all values to `dst`, growing it only when its capacity falls short. Pass
+// `dst[:0]` to reuse a buffer across calls.
+func (m Native[K, V]) ValuesInto(dst []V) []V {
+	for _, v := range m.data {
+		dst = append(dst, v)
+	}
+	return dst
+}
+
+// KeysInto appends all keys to `dst`, growing it only when its capacity falls short. Pass
+// `dst[:0]` to reuse a buffer across calls.
+func (m Native[K, V]) KeysInto(dst []K) []K {
+	for k := range m.data {
+		dst = append(dst, k)
+	}
+	return dst
+}
+
+// EntriesInto appends all entries to `dst`, growing it only when its capacity falls short. Pass
+// `dst[:0]` to reuse a buffer across calls.
+func (m Native[K, V]) EntriesInto(dst []Entry[K, V]) []Entry[K, V] {
+	for k, v := range m.data {
+		dst = append(dst, Entry[K, V]{K: k, V: v})
+	}
+	return dst
+}
+
+// AsMap returns a copy of the underlying map, so that it can be mutated freely.
+func (m Native[K, V]) AsMap() map[K]V {
+	res := make(map[K]V, len(m.data))
+	for k, v := range m.data {
+		res[k] = v
+	}
+	return res
+}
+
+// Iter returns an iterator over all entries, without allocating an intermediate slice.
+func (m Native[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m.data {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
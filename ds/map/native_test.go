@@ -0,0 +1,183 @@
+package _map
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFromMap(t *testing.T) {
+	payload := map[string]int{"a": 1, "b": 2}
+
+	m := FromMap(payload)
+
+	if v, ok := m.Get("a"); !ok || v != 1 {
+		t.Errorf("unexpected value, want (1, true), have (%d, %t)", v, ok)
+	}
+
+	payload["c"] = 3
+	if m.Has("c") {
+		t.Errorf("unexpected entry, source map mutations should not be visible")
+	}
+
+	m.Set("d", 4)
+	if _, ok := payload["d"]; ok {
+		t.Errorf("unexpected entry, map mutations should not leak into source map")
+	}
+
+	if empty := FromMap[string, int](nil); len(empty.Keys()) != 0 {
+		t.Errorf("unexpected length, want 0, have %d", len(empty.Keys()))
+	}
+}
+
+func TestNative_Iter(t *testing.T) {
+	m := FromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	sum := 0
+	for _, v := range m.Iter() {
+		sum += v
+	}
+
+	if sum != 6 {
+		t.Errorf("unexpected sum, want %d, have %d", 6, sum)
+	}
+
+	visited := 0
+	for range m.Iter() {
+		visited++
+		break
+	}
+
+	if visited != 1 {
+		t.Errorf("unexpected visited entries, want %d, have %d", 1, visited)
+	}
+}
+
+func TestNative_Into(t *testing.T) {
+	m := FromMap(map[string]int{"a": 1, "b": 2})
+
+	buf := make([]int, 0, 8)
+	values := m.ValuesInto(buf)
+
+	if len(values) != 2 || values[0]+values[1] != 3 {
+		t.Errorf("unexpected values, have %v", values)
+	}
+
+	if &values[:1][0] != &buf[:1][0] {
+		t.Errorf("unexpected allocation, buffer with enough capacity should be reused")
+	}
+
+	keys := m.KeysInto([]string{"z"})
+	if len(keys) != 3 || keys[0] != "z" {
+		t.Errorf("unexpected keys, want them appended after z, have %v", keys)
+	}
+
+	entries := m.EntriesInto(nil)
+	if len(entries) != 2 {
+		t.Errorf("unexpected entries length, want %d, have %d", 2, len(entries))
+	}
+}
+
+func benchmarkNative() Native[int, int] {
+	m := NewNative[int, int]()
+	for i := 0; i < 1000; i++ {
+		m.Set(i, i)
+	}
+	return m
+}
+
+func BenchmarkNative_Values(b *testing.B) {
+	m := benchmarkNative()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.Values()
+	}
+}
+
+func BenchmarkNative_ValuesInto(b *testing.B) {
+	m := benchmarkNative()
+	buf := make([]int, 0, 1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = m.ValuesInto(buf[:0])
+	}
+}
+
+func BenchmarkNative_Entries(b *testing.B) {
+	m := benchmarkNative()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.Entries()
+	}
+}
+
+func BenchmarkNative_EntriesInto(b *testing.B) {
+	m := benchmarkNative()
+	buf := make([]Entry[int, int], 0, 1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = m.EntriesInto(buf[:0])
+	}
+}
+
+func TestNative_ForEach(t *testing.T) {
+	m := FromMap(map[int]int{1: 1, 2: 2, 3: 3, 4: 4, 5: 5})
+	fail := errors.New("third entry")
+
+	calls := 0
+	err := m.ForEach(func(k, v int) error {
+		calls++
+		if calls == 3 {
+			return fail
+		}
+		return nil
+	})
+
+	if !errors.Is(err, fail) {
+		t.Errorf("unexpected error, want %v, have %v", fail, err)
+	}
+
+	if calls != 3 {
+		t.Errorf("unexpected calls, want %d, have %d", 3, calls)
+	}
+
+	calls = 0
+	if err = m.ForEach(func(k, v int) error { calls++; return nil }); err != nil || calls != 5 {
+		t.Errorf("unexpected result, want (nil, 5 calls), have (%v, %d calls)", err, calls)
+	}
+}
+
+func TestNative_GetOption(t *testing.T) {
+	m := FromMap(map[string]int{"a": 1})
+
+	if v := m.GetOption("a").UnwrapOr(0); v != 1 {
+		t.Errorf("unexpected value, want %d, have %d", 1, v)
+	}
+
+	if m.GetOption("b").IsSome() {
+		t.Errorf("unexpected value, want none for absent key")
+	}
+}
+
+func TestNative_SetIfAbsent(t *testing.T) {
+	m := FromMap(map[string]int{"a": 1})
+
+	if m.SetIfAbsent("a", 2) {
+		t.Errorf("unexpected store, want noop for present key")
+	}
+
+	if v, _ := m.Get("a"); v != 1 {
+		t.Errorf("unexpected value, want %d, have %d", 1, v)
+	}
+
+	if !m.SetIfAbsent("b", 2) {
+		t.Errorf("unexpected noop, want store for absent key")
+	}
+
+	if v, _ := m.Get("b"); v != 2 {
+		t.Errorf("unexpected value, want %d, have %d", 2, v)
+	}
+}
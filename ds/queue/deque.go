@@ -0,0 +1,106 @@
+// Package queue provides a generic double-ended queue backed by a ring buffer
+package queue
+
+const minCapacity = 8
+
+type (
+	// Deque is a double-ended queue backed by a ring buffer, allowing amortized O(1) insertions
+	// and removals at both ends. It is not safe for concurrent use.
+	Deque[T any] struct {
+		buf  []T
+		head int
+		size int
+	}
+)
+
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size < 1
+}
+
+func (d *Deque[T]) PushBack(item T) {
+	d.grow()
+	d.buf[d.index(d.size)] = item
+	d.size++
+}
+
+func (d *Deque[T]) PushFront(item T) {
+	d.grow()
+	d.head = d.index(len(d.buf) - 1)
+	d.buf[d.head] = item
+	d.size++
+}
+
+func (d *Deque[T]) PopFront() (item T, ok bool) {
+	if d.size < 1 {
+		return
+	}
+
+	var zero T
+	item, ok = d.buf[d.head], true
+	d.buf[d.head] = zero // GC
+	d.head = d.index(1)
+	d.size--
+	return
+}
+
+func (d *Deque[T]) PopBack() (item T, ok bool) {
+	if d.size < 1 {
+		return
+	}
+
+	var zero T
+	tail := d.index(d.size - 1)
+	item, ok = d.buf[tail], true
+	d.buf[tail] = zero // GC
+	d.size--
+	return
+}
+
+func (d *Deque[T]) PeekFront() (item T, ok bool) {
+	if d.size < 1 {
+		return
+	}
+
+	return d.buf[d.head], true
+}
+
+func (d *Deque[T]) PeekBack() (item T, ok bool) {
+	if d.size < 1 {
+		return
+	}
+
+	return d.buf[d.index(d.size-1)], true
+}
+
+// index translates a position relative to head into a position of the underlying buffer.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+// grow doubles the underlying buffer when it is full, unwrapping the ring so that head lays at
+// the start of the new buffer.
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+
+	capacity := len(d.buf) * 2
+	if capacity < minCapacity {
+		capacity = minCapacity
+	}
+
+	buf := make([]T, capacity)
+	n := copy(buf, d.buf[d.head:])
+	copy(buf[n:], d.buf[:d.head])
+
+	d.buf = buf
+	d.head = 0
+}
@@ -0,0 +1,88 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/sonirico/stadio/slices"
+)
+
+func TestDeque(t *testing.T) {
+	d := NewDeque[int]()
+
+	if item, ok := d.PopFront(); ok || item != 0 {
+		t.Errorf("unexpected pop on empty deque, want (0, false), have (%d, %t)", item, ok)
+	}
+
+	if item, ok := d.PopBack(); ok || item != 0 {
+		t.Errorf("unexpected pop on empty deque, want (0, false), have (%d, %t)", item, ok)
+	}
+
+	// interleave operations on both ends, forcing several grows with a wrapped ring
+	for i := 1; i <= 20; i++ {
+		d.PushBack(i)
+		d.PushFront(-i)
+	}
+
+	if d.Len() != 40 {
+		t.Errorf("unexpected length, want %d, have %d", 40, d.Len())
+	}
+
+	if item, ok := d.PeekFront(); !ok || item != -20 {
+		t.Errorf("unexpected front, want (-20, true), have (%d, %t)", item, ok)
+	}
+
+	if item, ok := d.PeekBack(); !ok || item != 20 {
+		t.Errorf("unexpected back, want (20, true), have (%d, %t)", item, ok)
+	}
+
+	for i := 20; i >= 1; i-- {
+		if item, ok := d.PopFront(); !ok || item != -i {
+			t.Errorf("unexpected front, want (%d, true), have (%d, %t)", -i, item, ok)
+		}
+
+		if item, ok := d.PopBack(); !ok || item != i {
+			t.Errorf("unexpected back, want (%d, true), have (%d, %t)", i, item, ok)
+		}
+	}
+
+	if !d.IsEmpty() {
+		t.Errorf("unexpected deque, want empty, have length %d", d.Len())
+	}
+
+	d.PushFront(1)
+	d.PushBack(2)
+
+	if item, ok := d.PopBack(); !ok || item != 2 {
+		t.Errorf("unexpected back, want (2, true), have (%d, %t)", item, ok)
+	}
+
+	if item, ok := d.PopBack(); !ok || item != 1 {
+		t.Errorf("unexpected back, want (1, true), have (%d, %t)", item, ok)
+	}
+}
+
+func BenchmarkDeque_PushFront(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d := NewDeque[int]()
+		for j := 0; j < 1000; j++ {
+			d.PushFront(j)
+		}
+		for !d.IsEmpty() {
+			d.PopFront()
+		}
+	}
+}
+
+func BenchmarkSlice_Unshift(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var arr []int
+		for j := 0; j < 1000; j++ {
+			arr = slices.Unshift(arr, j)
+		}
+		for len(arr) > 0 {
+			arr, _, _ = slices.Shift(arr)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Package set provides a generic set backed by a native map
+package set
+
+import (
+	"github.com/sonirico/stadio/slices"
+)
+
+type (
+	Set[T comparable] map[T]struct{}
+)
+
+// NewSet builds a set containing the given items.
+func NewSet[T comparable](items ...T) Set[T] {
+	return FromSlice(items)
+}
+
+// FromSlice builds a set containing the items of the given slice, discarding duplicates.
+func FromSlice[T comparable](items []T) Set[T] {
+	res := make(Set[T], len(items))
+	for _, x := range items {
+		res[x] = struct{}{}
+	}
+	return res
+}
+
+func (s Set[T]) Add(items ...T) {
+	for _, x := range items {
+		s[x] = struct{}{}
+	}
+}
+
+func (s Set[T]) Remove(items ...T) {
+	for _, x := range items {
+		delete(s, x)
+	}
+}
+
+func (s Set[T]) Has(item T) (ok bool) {
+	_, ok = s[item]
+	return
+}
+
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Slice returns the items of the set in no particular order.
+func (s Set[T]) Slice() slices.Slice[T] {
+	res := make([]T, 0, len(s))
+	for x := range s {
+		res = append(res, x)
+	}
+	return res
+}
+
+// Union returns a new set with the items present in either set.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	res := make(Set[T], len(s)+len(other))
+	for x := range s {
+		res[x] = struct{}{}
+	}
+	for x := range other {
+		res[x] = struct{}{}
+	}
+	return res
+}
+
+// Intersect returns a new set with the items present in both sets.
+func (s Set[T]) Intersect(other Set[T]) Set[T] {
+	small, big := s, other
+	if len(small) > len(big) {
+		small, big = big, small
+	}
+
+	res := make(Set[T], len(small))
+	for x := range small {
+		if big.Has(x) {
+			res[x] = struct{}{}
+		}
+	}
+	return res
+}
+
+// Difference returns a new set with the items present in the receiver but not in `other`.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	res := make(Set[T], len(s))
+	for x := range s {
+		if !other.Has(x) {
+			res[x] = struct{}{}
+		}
+	}
+	return res
+}
@@ -0,0 +1,58 @@
+package set
+
+import (
+	"sort"
+	"testing"
+)
+
+func assertSet(t *testing.T, expected []int, actual Set[int]) {
+	t.Helper()
+
+	items := actual.Slice()
+	sort.Ints(items)
+
+	if len(items) != len(expected) {
+		t.Fatalf("unexpected set, want %v, have %v", expected, items)
+	}
+
+	for i := range items {
+		if items[i] != expected[i] {
+			t.Fatalf("unexpected set, want %v, have %v", expected, items)
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	s := NewSet(1, 2, 2, 3)
+
+	if s.Len() != 3 {
+		t.Errorf("unexpected length, want %d, have %d", 3, s.Len())
+	}
+
+	s.Add(4)
+	s.Remove(1, 5)
+
+	if s.Has(1) || !s.Has(4) {
+		t.Errorf("unexpected items, have %v", s.Slice())
+	}
+
+	assertSet(t, []int{2, 3, 4}, s)
+	assertSet(t, []int{2, 3, 4}, FromSlice(s.Slice()))
+	assertSet(t, nil, FromSlice[int](nil))
+}
+
+func TestSet_Algebra(t *testing.T) {
+	one := NewSet(1, 2, 3)
+	other := NewSet(3, 4)
+
+	assertSet(t, []int{1, 2, 3, 4}, one.Union(other))
+	assertSet(t, []int{3}, one.Intersect(other))
+	assertSet(t, []int{3}, other.Intersect(one))
+	assertSet(t, []int{1, 2}, one.Difference(other))
+	assertSet(t, []int{4}, other.Difference(one))
+	assertSet(t, nil, one.Intersect(NewSet[int]()))
+
+	// operands must be left untouched
+	assertSet(t, []int{1, 2, 3}, one)
+	assertSet(t, []int{3, 4}, other)
+}
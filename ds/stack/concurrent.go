@@ -0,0 +1,50 @@
+package stack
+
+import (
+	"sync"
+)
+
+type (
+	Concurrent[T any] struct {
+		L     sync.RWMutex
+		inner Stack[T]
+	}
+)
+
+func NewConcurrent[T any]() *Concurrent[T] {
+	return &Concurrent[T]{}
+}
+
+func (s *Concurrent[T]) Push(item T) {
+	s.L.Lock()
+	s.inner.Push(item)
+	s.L.Unlock()
+}
+
+func (s *Concurrent[T]) Pop() (item T, ok bool) {
+	s.L.Lock()
+	item, ok = s.inner.Pop()
+	s.L.Unlock()
+	return
+}
+
+func (s *Concurrent[T]) Peek() (item T, ok bool) {
+	s.L.RLock()
+	item, ok = s.inner.Peek()
+	s.L.RUnlock()
+	return
+}
+
+func (s *Concurrent[T]) Len() (n int) {
+	s.L.RLock()
+	n = s.inner.Len()
+	s.L.RUnlock()
+	return
+}
+
+func (s *Concurrent[T]) IsEmpty() (ok bool) {
+	s.L.RLock()
+	ok = s.inner.IsEmpty()
+	s.L.RUnlock()
+	return
+}
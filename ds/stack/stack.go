@@ -0,0 +1,46 @@
+// Package stack provides a generic LIFO stack backed by a slice
+package stack
+
+import (
+	"github.com/sonirico/stadio/slices"
+)
+
+type (
+	// Stack is a LIFO collection. It is not safe for concurrent use, see Concurrent.
+	Stack[T any] struct {
+		data []T
+	}
+)
+
+func NewStack[T any]() *Stack[T] {
+	return &Stack[T]{}
+}
+
+func (s *Stack[T]) Push(item T) {
+	s.data = append(s.data, item)
+}
+
+func (s *Stack[T]) Pop() (item T, ok bool) {
+	if len(s.data) < 1 {
+		return
+	}
+
+	s.data, item, ok = slices.Pop(s.data)
+	return
+}
+
+func (s *Stack[T]) Peek() (item T, ok bool) {
+	if len(s.data) < 1 {
+		return
+	}
+
+	return s.data[len(s.data)-1], true
+}
+
+func (s *Stack[T]) Len() int {
+	return len(s.data)
+}
+
+func (s *Stack[T]) IsEmpty() bool {
+	return len(s.data) < 1
+}
@@ -0,0 +1,73 @@
+package stack
+
+import (
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	s := NewStack[int]()
+
+	if !s.IsEmpty() {
+		t.Errorf("unexpected stack, want empty, have length %d", s.Len())
+	}
+
+	if item, ok := s.Pop(); ok || item != 0 {
+		t.Errorf("unexpected pop on empty stack, want (0, false), have (%d, %t)", item, ok)
+	}
+
+	if item, ok := s.Peek(); ok || item != 0 {
+		t.Errorf("unexpected peek on empty stack, want (0, false), have (%d, %t)", item, ok)
+	}
+
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+
+	if item, ok := s.Peek(); !ok || item != 3 {
+		t.Errorf("unexpected peek, want (3, true), have (%d, %t)", item, ok)
+	}
+
+	if s.Len() != 3 {
+		t.Errorf("unexpected length, want %d, have %d", 3, s.Len())
+	}
+
+	for _, expected := range []int{3, 2, 1} {
+		if item, ok := s.Pop(); !ok || item != expected {
+			t.Errorf("unexpected pop, want (%d, true), have (%d, %t)", expected, item, ok)
+		}
+	}
+
+	if !s.IsEmpty() {
+		t.Errorf("unexpected stack, want empty, have length %d", s.Len())
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	s := NewConcurrent[int]()
+	done := make(chan struct{})
+
+	for w := 0; w < 4; w++ {
+		go func() {
+			for i := 0; i < 100; i++ {
+				s.Push(i)
+				_, _ = s.Peek()
+			}
+			done <- struct{}{}
+		}()
+	}
+
+	for w := 0; w < 4; w++ {
+		<-done
+	}
+
+	popped := 0
+	for !s.IsEmpty() {
+		if _, ok := s.Pop(); ok {
+			popped++
+		}
+	}
+
+	if popped != 400 {
+		t.Errorf("unexpected popped items, want %d, have %d", 400, popped)
+	}
+}
@@ -0,0 +1,166 @@
+// Package trie provides a generic prefix tree keyed by strings
+package trie
+
+import (
+	"sort"
+
+	"github.com/sonirico/stadio/tuples"
+)
+
+type (
+	node[V any] struct {
+		children map[byte]*node[V]
+		value    V
+		isSet    bool
+	}
+
+	// Trie maps string keys to values, supporting prefix queries. Keys are compared byte-wise,
+	// hence iteration happens in lexicographic order. It is not safe for concurrent use.
+	Trie[V any] struct {
+		root node[V]
+		size int
+	}
+)
+
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+func (t *Trie[V]) Insert(key string, v V) {
+	n := &t.root
+
+	for i := 0; i < len(key); i++ {
+		if n.children == nil {
+			n.children = make(map[byte]*node[V])
+		}
+
+		child, ok := n.children[key[i]]
+		if !ok {
+			child = new(node[V])
+			n.children[key[i]] = child
+		}
+
+		n = child
+	}
+
+	if !n.isSet {
+		t.size++
+	}
+
+	n.value = v
+	n.isSet = true
+}
+
+func (t *Trie[V]) Get(key string) (v V, ok bool) {
+	n := t.find(key)
+	if n == nil || !n.isSet {
+		return
+	}
+
+	return n.value, true
+}
+
+func (t *Trie[V]) Has(key string) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Delete removes `key`, pruning the nodes left without descendants. It returns whether the key
+// was present.
+func (t *Trie[V]) Delete(key string) bool {
+	if !t.root.delete(key) {
+		return false
+	}
+
+	t.size--
+	return true
+}
+
+// PrefixSearch returns all entries whose key starts with `prefix`, in lexicographic order.
+func (t *Trie[V]) PrefixSearch(prefix string) []tuples.Tuple2[string, V] {
+	res := make([]tuples.Tuple2[string, V], 0)
+
+	n := t.find(prefix)
+	if n == nil {
+		return res
+	}
+
+	buf := []byte(prefix)
+	n.walk(buf, func(k string, v V) bool {
+		res = append(res, tuples.Tuple2[string, V]{V1: k, V2: v})
+		return true
+	})
+
+	return res
+}
+
+// Range calls `fn` for every entry in lexicographic order, until it returns false.
+func (t *Trie[V]) Range(fn func(string, V) bool) {
+	t.root.walk(nil, fn)
+}
+
+func (t *Trie[V]) find(key string) *node[V] {
+	n := &t.root
+
+	for i := 0; i < len(key); i++ {
+		child, ok := n.children[key[i]]
+		if !ok {
+			return nil
+		}
+
+		n = child
+	}
+
+	return n
+}
+
+func (n *node[V]) delete(key string) bool {
+	if len(key) == 0 {
+		if !n.isSet {
+			return false
+		}
+
+		var zero V
+		n.value = zero
+		n.isSet = false
+		return true
+	}
+
+	child, ok := n.children[key[0]]
+	if !ok || !child.delete(key[1:]) {
+		return false
+	}
+
+	if !child.isSet && len(child.children) == 0 {
+		delete(n.children, key[0])
+	}
+
+	return true
+}
+
+// walk visits the subtree in lexicographic order, `prefix` being the key leading to `n`. It
+// returns false if `fn` asked to stop.
+func (n *node[V]) walk(prefix []byte, fn func(string, V) bool) bool {
+	if n.isSet && !fn(string(prefix), n.value) {
+		return false
+	}
+
+	keys := make([]byte, 0, len(n.children))
+	for k := range n.children {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	for _, k := range keys {
+		if !n.children[k].walk(append(prefix, k), fn) {
+			return false
+		}
+	}
+
+	return true
+}
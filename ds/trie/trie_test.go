@@ -0,0 +1,133 @@
+package trie
+
+import (
+	"testing"
+)
+
+func TestTrie(t *testing.T) {
+	trie := NewTrie[int]()
+
+	trie.Insert("tea", 1)
+	trie.Insert("ten", 2)
+	trie.Insert("to", 3)
+	trie.Insert("", 0)
+	trie.Insert("tea", 4)
+
+	if trie.Len() != 4 {
+		t.Errorf("unexpected length, want %d, have %d", 4, trie.Len())
+	}
+
+	if v, ok := trie.Get("tea"); !ok || v != 4 {
+		t.Errorf("unexpected value, want (4, true), have (%d, %t)", v, ok)
+	}
+
+	if v, ok := trie.Get(""); !ok || v != 0 {
+		t.Errorf("unexpected value for empty key, want (0, true), have (%d, %t)", v, ok)
+	}
+
+	for _, missing := range []string{"te", "teas", "x"} {
+		if trie.Has(missing) {
+			t.Errorf("unexpected key %q, want missing", missing)
+		}
+	}
+}
+
+func TestTrie_PrefixSearch(t *testing.T) {
+	trie := NewTrie[int]()
+
+	for i, k := range []string{"tombola", "ten", "to", "tea", "inn", "tomb"} {
+		trie.Insert(k, i)
+	}
+
+	type testCase struct {
+		name     string
+		prefix   string
+		expected []string
+	}
+
+	tests := []testCase{
+		{
+			name:     "prefix shared by several keys",
+			prefix:   "t",
+			expected: []string{"tea", "ten", "to", "tomb", "tombola"},
+		},
+		{
+			name:     "prefix matching a key exactly",
+			prefix:   "tomb",
+			expected: []string{"tomb", "tombola"},
+		},
+		{
+			name:     "empty prefix matches everything",
+			prefix:   "",
+			expected: []string{"inn", "tea", "ten", "to", "tomb", "tombola"},
+		},
+		{
+			name:     "missing prefix",
+			prefix:   "x",
+			expected: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := trie.PrefixSearch(test.prefix)
+
+			if len(actual) != len(test.expected) {
+				t.Fatalf("unexpected matches, want %v, have %v", test.expected, actual)
+			}
+
+			for i, entry := range actual {
+				if entry.V1 != test.expected[i] {
+					t.Errorf("unexpected match at %d, want %s, have %s",
+						i, test.expected[i], entry.V1)
+				}
+
+				if v, _ := trie.Get(entry.V1); v != entry.V2 {
+					t.Errorf("unexpected value for %s, want %d, have %d", entry.V1, v, entry.V2)
+				}
+			}
+		})
+	}
+}
+
+func TestTrie_Delete(t *testing.T) {
+	trie := NewTrie[int]()
+
+	trie.Insert("to", 1)
+	trie.Insert("tomb", 2)
+
+	if trie.Delete("tom") {
+		t.Errorf("unexpected deletion of missing key")
+	}
+
+	if !trie.Delete("tomb") || trie.Has("tomb") || !trie.Has("to") {
+		t.Errorf("unexpected entries after deleting tomb")
+	}
+
+	if len(trie.root.children['t'].children['o'].children) != 0 {
+		t.Errorf("unexpected nodes left after deletion")
+	}
+
+	if !trie.Delete("to") || trie.Len() != 0 || len(trie.root.children) != 0 {
+		t.Errorf("unexpected trie after deleting every key, have length %d", trie.Len())
+	}
+}
+
+func TestTrie_Range(t *testing.T) {
+	trie := NewTrie[int]()
+
+	for i, k := range []string{"b", "a", "ab", "c"} {
+		trie.Insert(k, i)
+	}
+
+	keys := make([]string, 0)
+	trie.Range(func(k string, _ int) bool {
+		keys = append(keys, k)
+		return len(keys) < 3
+	})
+
+	expected := []string{"a", "ab", "b"}
+	if len(keys) != len(expected) || keys[0] != "a" || keys[1] != "ab" || keys[2] != "b" {
+		t.Errorf("unexpected keys, want %v, have %v", expected, keys)
+	}
+}
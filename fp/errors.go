@@ -0,0 +1,51 @@
+package fp
+
+import "errors"
+
+var (
+	// ErrFiltered is the error held by a Result discarded by Filter or FilterOrElse when no
+	// error was given.
+	ErrFiltered = errors.New("result does not match filter")
+)
+
+type (
+	// UnwrapError is the value Option and Result panic with when unwrapped unsafely, so that a
+	// recovered panic can be told apart from any other one.
+	UnwrapError struct {
+		// Msg describes why unwrapping failed.
+		Msg string
+		// Type is the name of the type held by the Option or Result, e.g. "[]int".
+		Type string
+		// Err is the error held by a Result, nil for Options.
+		Err error
+	}
+)
+
+func (e *UnwrapError) Error() string {
+	if e.Err != nil {
+		return e.Msg + ": " + e.Err.Error()
+	}
+	return e.Msg
+}
+
+func (e *UnwrapError) Unwrap() error {
+	return e.Err
+}
+
+// newUnwrapError builds the panic value of unwrapping an Option or Result, `kind` being either
+// "option" or "result", optionally prefixed by the message given to Expect. Options pass a nil
+// `err`.
+func newUnwrapError[T any](kind, prefix string, err error) *UnwrapError {
+	name := typeName[T]()
+
+	msg := kind + "[" + name + "] is none"
+	if err != nil {
+		msg = kind + "[" + name + "] is error"
+	}
+
+	if prefix != "" {
+		msg = prefix + ": " + msg
+	}
+
+	return &UnwrapError{Msg: msg, Type: name, Err: err}
+}
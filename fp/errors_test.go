@@ -0,0 +1,100 @@
+package fp
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+func recoverUnwrapError(t *testing.T, fn func()) (res *UnwrapError) {
+	t.Helper()
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatalf("unexpected result, want panic, have none")
+		}
+
+		err, ok := r.(error)
+		if !ok || !errors.As(err, &res) {
+			t.Fatalf("unexpected panic value, want *UnwrapError, have %T", r)
+		}
+	}()
+
+	fn()
+	return
+}
+
+func TestUnwrapError(t *testing.T) {
+	err := recoverUnwrapError(t, func() { None[int]().UnwrapUnsafe() })
+
+	if err.Error() != "option[int] is none" || err.Type != "int" {
+		t.Errorf("unexpected message, want 'option[int] is none', have %q", err.Error())
+	}
+
+	err = recoverUnwrapError(t, func() { Err[int](io.EOF).UnwrapUnsafe() })
+
+	if err.Error() != "result[int] is error: EOF" || err.Type != "int" {
+		t.Errorf("unexpected message, want 'result[int] is error: EOF', have %q", err.Error())
+	}
+
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("unexpected wrapped err, want io.EOF, have %v", err.Err)
+	}
+}
+
+func TestUnwrapError_TypeName(t *testing.T) {
+	type testCase struct {
+		name     string
+		fn       func()
+		expected string
+	}
+
+	type user struct{}
+
+	tests := []testCase{
+		{
+			name:     "option of string",
+			fn:       func() { None[string]().UnwrapUnsafe() },
+			expected: "option[string] is none",
+		},
+		{
+			name:     "option of interface",
+			fn:       func() { None[error]().UnwrapUnsafe() },
+			expected: "option[error] is none",
+		},
+		{
+			name:     "result of slice of pointers",
+			fn:       func() { Err[[]*user](io.EOF).UnwrapUnsafe() },
+			expected: "result[[]*fp.user] is error: EOF",
+		},
+		{
+			name:     "option expect",
+			fn:       func() { None[float64]().Expect("price must be set") },
+			expected: "price must be set: option[float64] is none",
+		},
+		{
+			name:     "result expect",
+			fn:       func() { Err[map[string]int](io.EOF).Expect("config must load") },
+			expected: "config must load: result[map[string]int] is error: EOF",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := recoverUnwrapError(t, test.fn)
+
+			if err.Error() != test.expected {
+				t.Errorf("unexpected message, want %q, have %q", test.expected, err.Error())
+			}
+		})
+	}
+
+	if value := Some(1).Expect("never panics"); value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	if value := Ok("uno").Expect("never panics"); value != "uno" {
+		t.Errorf("unexpected result, want uno, have %s", value)
+	}
+}
@@ -0,0 +1,40 @@
+package fp
+
+import (
+	"fmt"
+	"reflect"
+)
+
+func (o Option[T]) String() string {
+	if o.isSome {
+		return fmt.Sprintf("Some(%v)", o.value)
+	}
+	return "None"
+}
+
+func (o Option[T]) GoString() string {
+	if o.isSome {
+		return fmt.Sprintf("fp.Some[%s](%#v)", typeName[T](), o.value)
+	}
+	return fmt.Sprintf("fp.None[%s]()", typeName[T]())
+}
+
+func (r Result[T]) String() string {
+	if r.err == nil {
+		return fmt.Sprintf("Ok(%v)", r.value)
+	}
+	return fmt.Sprintf("Err(%s)", r.err.Error())
+}
+
+func (r Result[T]) GoString() string {
+	if r.err == nil {
+		return fmt.Sprintf("fp.Ok[%s](%#v)", typeName[T](), r.value)
+	}
+	return fmt.Sprintf("fp.Err[%s](%#v)", typeName[T](), r.err)
+}
+
+// typeName returns the name of the type parameter, even for interface types whose zero value
+// carries no dynamic type.
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
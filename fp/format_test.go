@@ -0,0 +1,71 @@
+package fp
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	type testCase struct {
+		name     string
+		payload  any
+		expected string
+		format   string
+	}
+
+	tests := []testCase{
+		{
+			name:     "some",
+			payload:  Some(5),
+			format:   "%v",
+			expected: "Some(5)",
+		},
+		{
+			name:     "none",
+			payload:  None[int](),
+			format:   "%s",
+			expected: "None",
+		},
+		{
+			name:     "ok",
+			payload:  Ok("five"),
+			format:   "%v",
+			expected: "Ok(five)",
+		},
+		{
+			name:     "err",
+			payload:  Err[int](errors.New("cannot divide by zero")),
+			format:   "%v",
+			expected: "Err(cannot divide by zero)",
+		},
+		{
+			name:     "some go syntax",
+			payload:  Some("five"),
+			format:   "%#v",
+			expected: `fp.Some[string]("five")`,
+		},
+		{
+			name:     "none go syntax",
+			payload:  None[any](),
+			format:   "%#v",
+			expected: "fp.None[interface {}]()",
+		},
+		{
+			name:     "ok go syntax",
+			payload:  Ok(5),
+			format:   "%#v",
+			expected: "fp.Ok[int](5)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := fmt.Sprintf(test.format, test.payload)
+
+			if test.expected != actual {
+				t.Errorf("unexpected format, want %q, have %q", test.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package fp
+
+import "sync"
+
+// Memoize wraps `fn` so that it runs only on the first call, returning the cached result on
+// subsequent ones. It is not safe for concurrent use, see MemoizeSync.
+func Memoize[T any](fn func() T) func() T {
+	var cache Option[T]
+	return func() T {
+		return cache.GetOrInsertWith(fn)
+	}
+}
+
+// MemoizeKeyed wraps `fn` so that it runs only once per key, returning the cached result on
+// subsequent calls with the same key. It is not safe for concurrent use, see MemoizeKeyedSync.
+func MemoizeKeyed[K comparable, V any](fn func(K) V) func(K) V {
+	cache := make(map[K]V)
+	return func(k K) V {
+		if v, ok := cache[k]; ok {
+			return v
+		}
+		v := fn(k)
+		cache[k] = v
+		return v
+	}
+}
+
+// MemoizeSync is the concurrent safe version of Memoize.
+func MemoizeSync[T any](fn func() T) func() T {
+	var (
+		once  sync.Once
+		value T
+	)
+	return func() T {
+		once.Do(func() { value = fn() })
+		return value
+	}
+}
+
+// MemoizeKeyedSync is the concurrent safe version of MemoizeKeyed. Every key has its own
+// sync.Once, so `fn` is called exactly once per key, a slow call only blocks the callers of the
+// same key, and `fn` may recursively call the memoized function with other keys.
+func MemoizeKeyedSync[K comparable, V any](fn func(K) V) func(K) V {
+	type entry struct {
+		once  sync.Once
+		value V
+	}
+
+	var cache sync.Map
+	return func(k K) V {
+		cached, ok := cache.Load(k)
+		if !ok {
+			cached, _ = cache.LoadOrStore(k, &entry{})
+		}
+		e := cached.(*entry)
+		e.once.Do(func() { e.value = fn(k) })
+		return e.value
+	}
+}
@@ -0,0 +1,146 @@
+package fp
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestMemoize(t *testing.T) {
+	calls := 0
+	fn := Memoize(func() int {
+		calls++
+		return 42
+	})
+
+	for i := 0; i < 3; i++ {
+		if value := fn(); value != 42 {
+			t.Errorf("unexpected result, want 42, have %d", value)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("unexpected calls, want 1, have %d", calls)
+	}
+}
+
+func TestMemoizeKeyed(t *testing.T) {
+	calls := make(map[int]int)
+	fn := MemoizeKeyed(func(x int) int {
+		calls[x]++
+		return x * x
+	})
+
+	for i := 0; i < 3; i++ {
+		if value := fn(2); value != 4 {
+			t.Errorf("unexpected result, want 4, have %d", value)
+		}
+		if value := fn(3); value != 9 {
+			t.Errorf("unexpected result, want 9, have %d", value)
+		}
+	}
+
+	if calls[2] != 1 || calls[3] != 1 {
+		t.Errorf("unexpected calls, want one per key, have %v", calls)
+	}
+}
+
+func TestMemoizeSync(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		calls int
+		keyed = make(map[int]int)
+	)
+
+	fn := MemoizeSync(func() int {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		return 42
+	})
+
+	fnKeyed := MemoizeKeyedSync(func(x int) int {
+		mu.Lock()
+		keyed[x]++
+		mu.Unlock()
+		return x * x
+	})
+
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				if value := fn(); value != 42 {
+					t.Errorf("unexpected result, want 42, have %d", value)
+				}
+				if value := fnKeyed(i % 4); value != (i%4)*(i%4) {
+					t.Errorf("unexpected result, want %d, have %d", (i%4)*(i%4), value)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("unexpected calls, want 1, have %d", calls)
+	}
+
+	for k, n := range keyed {
+		if n != 1 {
+			t.Errorf("unexpected calls for key %d, want 1, have %d", k, n)
+		}
+	}
+}
+
+func TestMemoizeKeyedSync_Recursive(t *testing.T) {
+	var fib func(int) int
+	fib = MemoizeKeyedSync(func(n int) int {
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	})
+
+	if value := fib(50); value != 12586269025 {
+		t.Errorf("unexpected result, want 12586269025, have %d", value)
+	}
+}
+
+func TestMemoizeKeyedSync_SlowKey(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	fn := MemoizeKeyedSync(func(x int) int {
+		if x == 0 {
+			close(started)
+			<-release
+		}
+		return x * x
+	})
+
+	done := make(chan int)
+	go func() { done <- fn(0) }()
+
+	<-started
+
+	if value := fn(3); value != 9 {
+		t.Errorf("unexpected result, want 9, have %d", value)
+	}
+
+	close(release)
+
+	if value := <-done; value != 0 {
+		t.Errorf("unexpected result, want 0, have %d", value)
+	}
+}
+
+func TestMemoizeKeyedSync_HitAllocations(t *testing.T) {
+	fn := MemoizeKeyedSync(func(x int) int { return x * x })
+	_ = fn(3)
+
+	if allocs := testing.AllocsPerRun(100, func() { _ = fn(3) }); allocs != 0 {
+		t.Errorf("unexpected allocations on cache hit, want 0, have %v", allocs)
+	}
+}
@@ -1,5 +1,7 @@
 package fp
 
+import "iter"
+
 type (
 	Option[T any] struct {
 		value  T
@@ -19,6 +21,11 @@ func (o Option[T]) Unwrap() (T, bool) {
 	return o.value, o.isSome
 }
 
+// ToTuple is an alias of Unwrap, named after its counterpart OptionFromTuple.
+func (o Option[T]) ToTuple() (T, bool) {
+	return o.value, o.isSome
+}
+
 func (o Option[T]) UnwrapOr(value T) T {
 	if o.isSome {
 		return o.value
@@ -41,13 +48,50 @@ func (o Option[T]) UnwrapOrDefault() T {
 	return res
 }
 
+// UnwrapOrZero is an alias of UnwrapOrDefault, returning the zero value on None.
+func (o Option[T]) UnwrapOrZero() T {
+	return o.UnwrapOrDefault()
+}
+
+// OrZero is an alias of UnwrapOrDefault and UnwrapOrZero, returning the zero value on None.
+func (o Option[T]) OrZero() T {
+	return o.UnwrapOrDefault()
+}
+
+// UnwrapUnsafe returns the value, panicking with an *UnwrapError naming the type, e.g.
+// "option[string] is none", if the option is None.
 func (o Option[T]) UnwrapUnsafe() T {
 	if !o.isSome {
-		panic("option is none")
+		panic(newUnwrapError[T]("option", "", nil))
+	}
+	return o.value
+}
+
+// Expect is like UnwrapUnsafe, prefixing the panic message with `msg` to tell why the option
+// was expected to be Some.
+func (o Option[T]) Expect(msg string) T {
+	if !o.isSome {
+		panic(newUnwrapError[T]("option", msg, nil))
+	}
+	return o.value
+}
+
+func (o *Option[T]) GetOrInsert(value T) T {
+	if !o.isSome {
+		*o = Some(value)
+	}
+	return o.value
+}
+
+func (o *Option[T]) GetOrInsertWith(fn func() T) T {
+	if !o.isSome {
+		*o = Some(fn())
 	}
 	return o.value
 }
 
+// Or returns the receiver if it is Some, `other` otherwise. As any argument, `other` is
+// evaluated even when the receiver is Some; use OrElse when building it is expensive.
 func (o Option[T]) Or(other Option[T]) Option[T] {
 	if !o.isSome {
 		return other
@@ -55,6 +99,8 @@ func (o Option[T]) Or(other Option[T]) Option[T] {
 	return o
 }
 
+// OrElse returns the receiver if it is Some, the result of `fn` otherwise. Unlike Or, the
+// fallback is only computed when needed.
 func (o Option[T]) OrElse(fn func() Option[T]) Option[T] {
 	if !o.isSome {
 		return fn()
@@ -62,6 +108,20 @@ func (o Option[T]) OrElse(fn func() Option[T]) Option[T] {
 	return o
 }
 
+func (o Option[T]) And(other Option[T]) Option[T] {
+	if o.isSome {
+		return other
+	}
+	return o
+}
+
+func (o Option[T]) AndThen(fn func(T) Option[T]) Option[T] {
+	if o.isSome {
+		return fn(o.value)
+	}
+	return o
+}
+
 func (o Option[T]) Map(fn func(T) T) Option[T] {
 	if o.isSome {
 		return Some(fn(o.value))
@@ -105,6 +165,21 @@ func (o Option[T]) Match(handleSome func(T) Option[T], handleNone func() Option[
 	return handleNone()
 }
 
+func (o Option[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.isSome {
+			yield(o.value)
+		}
+	}
+}
+
+func Flatten[T any](o Option[Option[T]]) Option[T] {
+	if o.isSome {
+		return o.value
+	}
+	return None[T]()
+}
+
 func Some[T any](t T) Option[T] {
 	return Option[T]{value: t, isSome: true}
 }
@@ -112,3 +187,83 @@ func Some[T any](t T) Option[T] {
 func None[T any]() Option[T] {
 	return Option[T]{}
 }
+
+// OptionFromTuple builds an Option from the idiomatic (value, ok) return pair: Some if `ok`,
+// None otherwise.
+func OptionFromTuple[T any](t T, ok bool) Option[T] {
+	if ok {
+		return Some(t)
+	}
+	return None[T]()
+}
+
+func MapOptionOr[T, U any](o Option[T], value U, fn func(T) U) U {
+	if o.isSome {
+		return fn(o.value)
+	}
+	return value
+}
+
+func MapOptionOrElse[T, U any](o Option[T], handleNone func() U, handleSome func(T) U) U {
+	if o.isSome {
+		return handleSome(o.value)
+	}
+	return handleNone()
+}
+
+// OrEmpty unwraps an optional slice, returning a non-nil empty slice on None or when the
+// wrapped slice is nil. Unlike OrZero, the result is always safe to index by length.
+func OrEmpty[T any](o Option[[]T]) []T {
+	if o.isSome && o.value != nil {
+		return o.value
+	}
+	return []T{}
+}
+
+// OrEmptyMap unwraps an optional map, returning a non-nil empty map on None or when the
+// wrapped map is nil. Unlike OrZero, the result is always safe to write to.
+func OrEmptyMap[K comparable, V any](o Option[map[K]V]) map[K]V {
+	if o.isSome && o.value != nil {
+		return o.value
+	}
+	return map[K]V{}
+}
+
+// EqualOption reports whether both options are None, or both are Some holding values deemed
+// equal by `eq`.
+func EqualOption[T any](a, b Option[T], eq func(T, T) bool) bool {
+	if a.isSome != b.isSome {
+		return false
+	}
+
+	return !a.isSome || eq(a.value, b.value)
+}
+
+// EqualOptionComparable is like EqualOption, comparing the values with ==.
+func EqualOptionComparable[T comparable](a, b Option[T]) bool {
+	return EqualOption(a, b, func(x, y T) bool { return x == y })
+}
+
+// Coalesce returns the first Some among `opts`, or None if all of them are None. It is the
+// generalization of Or to any number of alternatives.
+func Coalesce[T any](opts ...Option[T]) Option[T] {
+	for _, o := range opts {
+		if o.isSome {
+			return o
+		}
+	}
+
+	return None[T]()
+}
+
+// CoalesceWith is the lazy counterpart of Coalesce, as OrElse is to Or: functions are called
+// in order until one of them returns Some, and the remaining ones are not called.
+func CoalesceWith[T any](fns ...func() Option[T]) Option[T] {
+	for _, fn := range fns {
+		if o := fn(); o.isSome {
+			return o
+		}
+	}
+
+	return None[T]()
+}
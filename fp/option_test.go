@@ -3,6 +3,7 @@ package fp
 import (
 	"errors"
 	"io"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -127,6 +128,49 @@ func TestOption_UnwrapOrDefault(t *testing.T) {
 	}
 }
 
+func TestOption_GetOrInsert(t *testing.T) {
+	some := Some(1)
+	none := None[int]()
+
+	if value := some.GetOrInsert(2); value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	if value := none.GetOrInsert(2); value != 2 {
+		t.Errorf("unexpected result, want 2, have %d", value)
+	}
+
+	if value := none.UnwrapUnsafe(); value != 2 {
+		t.Errorf("unexpected inserted value, want 2, have %d", value)
+	}
+}
+
+func TestOption_GetOrInsertWith(t *testing.T) {
+	some := Some(1)
+	none := None[int]()
+	calls := 0
+	fn := func() int {
+		calls++
+		return 2
+	}
+
+	if value := some.GetOrInsertWith(fn); value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	if calls != 0 {
+		t.Errorf("unexpected calls on some, want 0, have %d", calls)
+	}
+
+	if value := none.GetOrInsertWith(fn); value != 2 {
+		t.Errorf("unexpected result, want 2, have %d", value)
+	}
+
+	if value := none.GetOrInsertWith(fn); value != 2 || calls != 1 {
+		t.Errorf("unexpected result, want (2, 1 call), have (%d, %d calls)", value, calls)
+	}
+}
+
 func TestOption_Map(t *testing.T) {
 	some := Some("TOMBOLA")
 	none := None[string]()
@@ -244,3 +288,281 @@ func TestOption_Match(t *testing.T) {
 		t.Errorf("unexpected result, want test, have %s", value)
 	}
 }
+
+func TestOption_Iter(t *testing.T) {
+	some := Some("TOMBOLA")
+	none := None[string]()
+
+	values := make([]string, 0)
+	for x := range some.Iter() {
+		values = append(values, x)
+	}
+
+	if len(values) != 1 || values[0] != "TOMBOLA" {
+		t.Errorf("unexpected result, want [TOMBOLA], have %v", values)
+	}
+
+	for x := range none.Iter() {
+		t.Errorf("unexpected result, want no values, have %s", x)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	value := Flatten(Some(Some(1))).UnwrapUnsafe()
+
+	if value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	if Flatten(Some(None[int]())).IsSome() {
+		t.Error("unexpected result, want none, have some")
+	}
+
+	if Flatten(None[Option[int]]()).IsSome() {
+		t.Error("unexpected result, want none, have some")
+	}
+}
+
+func TestOption_And(t *testing.T) {
+	some := Some(1)
+	none := None[int]()
+
+	value := some.And(Some(2)).UnwrapUnsafe()
+
+	if value != 2 {
+		t.Errorf("unexpected result, want 2, have %d", value)
+	}
+
+	if some.And(none).IsSome() {
+		t.Error("unexpected result, want none, have some")
+	}
+
+	if none.And(Some(2)).IsSome() {
+		t.Error("unexpected result, want none, have some")
+	}
+
+	if none.And(none).IsSome() {
+		t.Error("unexpected result, want none, have some")
+	}
+}
+
+func TestOption_AndThen(t *testing.T) {
+	some := Some(2)
+	none := None[int]()
+	half := func(x int) Option[int] {
+		if x%2 != 0 {
+			return None[int]()
+		}
+		return Some(x / 2)
+	}
+
+	value := some.AndThen(half).UnwrapUnsafe()
+
+	if value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	if some.AndThen(half).AndThen(half).IsSome() {
+		t.Error("unexpected result, want none, have some")
+	}
+
+	if none.AndThen(half).IsSome() {
+		t.Error("unexpected result, want none, have some")
+	}
+}
+
+func TestOption_UnwrapOrZero(t *testing.T) {
+	if value := Some(1).UnwrapOrZero(); value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	if value := None[int]().UnwrapOrZero(); value != 0 {
+		t.Errorf("unexpected result, want 0, have %d", value)
+	}
+}
+
+func TestOption_LazyVariants(t *testing.T) {
+	some := Some(1)
+	calls := 0
+
+	_ = some.OrElse(func() Option[int] { calls++; return Some(2) })
+	_ = some.UnwrapOrElse(func() int { calls++; return 2 })
+	_ = some.MapOrElse(func() int { calls++; return 2 }, func(x int) int { return x })
+	_ = some.OkOrElse(func() error { calls++; return io.EOF })
+	_ = some.GetOrInsertWith(func() int { calls++; return 2 })
+	_ = None[int]().AndThen(func(x int) Option[int] { calls++; return Some(x) })
+
+	if calls != 0 {
+		t.Errorf("unexpected callback calls, want 0, have %d", calls)
+	}
+}
+
+func TestOption_Tuple(t *testing.T) {
+	lookup := func(k string) (int, bool) {
+		v, ok := map[string]int{"a": 1}[k]
+		return v, ok
+	}
+
+	value, ok := OptionFromTuple(lookup("a")).ToTuple()
+
+	if !ok || value != 1 {
+		t.Errorf("unexpected result, want (1, true), have (%d, %t)", value, ok)
+	}
+
+	if OptionFromTuple(lookup("b")).IsSome() {
+		t.Error("unexpected result, want none, have some")
+	}
+
+	if OptionFromTuple(None[int]().ToTuple()).IsSome() {
+		t.Error("unexpected result, want none, have some")
+	}
+}
+
+func TestMapOptionOr(t *testing.T) {
+	value := MapOptionOr(Some(5), "none", strconv.Itoa)
+
+	if value != "5" {
+		t.Errorf("unexpected result, want 5, have %s", value)
+	}
+
+	value = MapOptionOr(None[int](), "none", strconv.Itoa)
+
+	if value != "none" {
+		t.Errorf("unexpected result, want none, have %s", value)
+	}
+}
+
+func TestMapOptionOrElse(t *testing.T) {
+	calls := 0
+	handleNone := func() string {
+		calls++
+		return "none"
+	}
+
+	value := MapOptionOrElse(Some(5), handleNone, strconv.Itoa)
+
+	if value != "5" || calls != 0 {
+		t.Errorf("unexpected result, want (5, 0 calls), have (%s, %d calls)", value, calls)
+	}
+
+	value = MapOptionOrElse(None[int](), handleNone, strconv.Itoa)
+
+	if value != "none" || calls != 1 {
+		t.Errorf("unexpected result, want (none, 1 call), have (%s, %d calls)", value, calls)
+	}
+}
+
+func TestOption_OrZero(t *testing.T) {
+	if value := Some(1).OrZero(); value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	if value := None[int]().OrZero(); value != 0 {
+		t.Errorf("unexpected result, want 0, have %d", value)
+	}
+}
+
+func TestOrEmpty(t *testing.T) {
+	if value := OrEmpty(None[[]int]()); value == nil || len(value) != 0 {
+		t.Errorf("unexpected result, want empty non-nil slice, have %v", value)
+	}
+
+	if value := OrEmpty(Some[[]int](nil)); value == nil {
+		t.Error("unexpected result, want empty non-nil slice, have nil")
+	}
+
+	if value := OrEmpty(Some([]int{1, 2})); len(value) != 2 {
+		t.Errorf("unexpected result, want [1 2], have %v", value)
+	}
+
+	empty := OrEmptyMap(None[map[string]int]())
+	if empty == nil {
+		t.Error("unexpected result, want empty non-nil map, have nil")
+	}
+	empty["a"] = 1
+
+	if value := OrEmptyMap(Some(map[string]int{"a": 1})); value["a"] != 1 {
+		t.Errorf("unexpected result, want map[a:1], have %v", value)
+	}
+}
+
+func TestEqualOption(t *testing.T) {
+	type testCase struct {
+		name     string
+		a, b     Option[int]
+		expected bool
+	}
+
+	tests := []testCase{
+		{name: "none and none", a: None[int](), b: None[int](), expected: true},
+		{name: "some and none", a: Some(1), b: None[int](), expected: false},
+		{name: "none and some", a: None[int](), b: Some(1), expected: false},
+		{name: "some equal", a: Some(1), b: Some(1), expected: true},
+		{name: "some unequal", a: Some(1), b: Some(2), expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := EqualOptionComparable(test.a, test.b); actual != test.expected {
+				t.Errorf("unexpected result, want %t, have %t", test.expected, actual)
+			}
+		})
+	}
+
+	eqFold := func(x, y string) bool { return strings.EqualFold(x, y) }
+
+	if !EqualOption(Some("TOMBOLA"), Some("tombola"), eqFold) {
+		t.Error("unexpected result, want equal, have unequal")
+	}
+
+	if EqualOption(Some("TOMBOLA"), None[string](), eqFold) {
+		t.Error("unexpected result, want unequal, have equal")
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	type testCase struct {
+		name     string
+		payload  []Option[int]
+		expected Option[int]
+	}
+
+	tests := []testCase{
+		{name: "no options", payload: nil, expected: None[int]()},
+		{name: "all none", payload: []Option[int]{None[int](), None[int]()}, expected: None[int]()},
+		{name: "some first", payload: []Option[int]{Some(1), Some(2)}, expected: Some(1)},
+		{
+			name:     "some in the middle",
+			payload:  []Option[int]{None[int](), Some(2), Some(3)},
+			expected: Some(2),
+		},
+		{
+			name:     "some last",
+			payload:  []Option[int]{None[int](), None[int](), Some(3)},
+			expected: Some(3),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := Coalesce(test.payload...); actual != test.expected {
+				t.Errorf("unexpected result, want %v, have %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCoalesceWith(t *testing.T) {
+	calls := 0
+	none := func() Option[string] { calls++; return None[string]() }
+	env := func() Option[string] { calls++; return Some("env") }
+	file := func() Option[string] { calls++; return Some("file") }
+
+	if value := CoalesceWith(none, env, file).UnwrapUnsafe(); value != "env" || calls != 2 {
+		t.Errorf("unexpected result, want (env, 2 calls), have (%s, %d calls)", value, calls)
+	}
+
+	if CoalesceWith(none, none).IsSome() {
+		t.Error("unexpected result, want none, have some")
+	}
+}
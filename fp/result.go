@@ -1,5 +1,7 @@
 package fp
 
+import "github.com/sonirico/stadio/tuples"
+
 type (
 	Result[T any] struct {
 		value T
@@ -19,9 +21,21 @@ func (r Result[T]) IsErr() bool {
 	return r.err != nil
 }
 
+// UnwrapUnsafe returns the value, panicking with an *UnwrapError naming the type and wrapping
+// the error, e.g. "result[string] is error: EOF", if the result is Err.
 func (r Result[T]) UnwrapUnsafe() T {
 	if r.err != nil {
-		panic("result is error: " + r.err.Error())
+		panic(newUnwrapError[T]("result", "", r.err))
+	}
+
+	return r.value
+}
+
+// Expect is like UnwrapUnsafe, prefixing the panic message with `msg` to tell why the result
+// was expected to be Ok.
+func (r Result[T]) Expect(msg string) T {
+	if r.err != nil {
+		panic(newUnwrapError[T]("result", msg, r.err))
 	}
 
 	return r.value
@@ -31,6 +45,11 @@ func (r Result[T]) Unwrap() (T, error) {
 	return r.value, r.err
 }
 
+// ToTuple is an alias of Unwrap, named after its counterpart ResultFromTuple.
+func (r Result[T]) ToTuple() (T, error) {
+	return r.value, r.err
+}
+
 func (r Result[T]) UnwrapOr(other T) T {
 	if r.err == nil {
 		return r.value
@@ -56,6 +75,34 @@ func (r Result[T]) UnwrapOrDefault() T {
 	return res
 }
 
+// UnwrapOrZero is an alias of UnwrapOrDefault, returning the zero value on Err.
+func (r Result[T]) UnwrapOrZero() T {
+	return r.UnwrapOrDefault()
+}
+
+// OrZero is an alias of UnwrapOrDefault and UnwrapOrZero, returning the zero value on Err.
+func (r Result[T]) OrZero() T {
+	return r.UnwrapOrDefault()
+}
+
+func (r Result[T]) OkOption() Option[T] {
+	if r.err == nil {
+		return Some(r.value)
+	}
+
+	return None[T]()
+}
+
+func (r Result[T]) ErrOption() Option[error] {
+	if r.err != nil {
+		return Some(r.err)
+	}
+
+	return None[error]()
+}
+
+// Or returns the receiver if it is Ok, `other` otherwise. As any argument, `other` is
+// evaluated even when the receiver is Ok; use OrElse when building it is expensive.
 func (r Result[T]) Or(other Result[T]) Result[T] {
 	if r.err == nil {
 		return r
@@ -64,6 +111,8 @@ func (r Result[T]) Or(other Result[T]) Result[T] {
 	return other
 }
 
+// OrElse returns the receiver if it is Ok, the result of `fn` otherwise. Unlike Or, the
+// fallback is only computed when needed.
 func (r Result[T]) OrElse(fn func() Result[T]) Result[T] {
 	if r.err == nil {
 		return r
@@ -91,6 +140,8 @@ func (r Result[T]) And(other Result[T]) Result[T] {
 	return r
 }
 
+// AndThen replaces the Ok value by the one computed by `fn`, which does not receive the
+// current value. See AndThenValue to compute the new value from the current one.
 func (r Result[T]) AndThen(fn func() T) Result[T] {
 	if r.err == nil {
 		return Ok(fn())
@@ -99,6 +150,22 @@ func (r Result[T]) AndThen(fn func() T) Result[T] {
 	return r
 }
 
+func (r Result[T]) AndThenValue(fn func(T) T) Result[T] {
+	if r.err == nil {
+		return Ok(fn(r.value))
+	}
+
+	return r
+}
+
+func (r Result[T]) AndThenTry(fn func(T) Result[T]) Result[T] {
+	if r.err == nil {
+		return fn(r.value)
+	}
+
+	return r
+}
+
 func (r Result[T]) Map(fn func(T) T) Result[T] {
 	if r.err == nil {
 		return Ok(fn(r.value))
@@ -126,6 +193,59 @@ func (r Result[T]) MapOrElse(
 	return Ok(handleErr(r.err))
 }
 
+// Recover turns an Err into an Ok holding the value computed by `fn` from the error, leaving
+// an Ok untouched. Unlike MapOrElse, Ok values are not transformed.
+func (r Result[T]) Recover(fn func(error) T) Result[T] {
+	if r.err != nil {
+		return Ok(fn(r.err))
+	}
+
+	return r
+}
+
+// Filter turns an Ok into an Err holding `err` when its value does not match `fn`. A nil `err`
+// falls back to ErrFiltered, so that a failed check never yields an Ok.
+func (r Result[T]) Filter(fn func(T) bool, err error) Result[T] {
+	if r.err == nil && !fn(r.value) {
+		return Err[T](filterErr(err))
+	}
+
+	return r
+}
+
+// FilterOrElse is the lazy counterpart of Filter, calling `handleErr` only when the value does
+// not match `fn`. A nil error from `handleErr` falls back to ErrFiltered.
+func (r Result[T]) FilterOrElse(fn func(T) bool, handleErr func() error) Result[T] {
+	if r.err == nil && !fn(r.value) {
+		return Err[T](filterErr(handleErr()))
+	}
+
+	return r
+}
+
+func filterErr(err error) error {
+	if err == nil {
+		return ErrFiltered
+	}
+	return err
+}
+
+func (r Result[T]) Tap(fn func(T)) Result[T] {
+	if r.err == nil {
+		fn(r.value)
+	}
+
+	return r
+}
+
+func (r Result[T]) TapErr(fn func(error)) Result[T] {
+	if r.err != nil {
+		fn(r.err)
+	}
+
+	return r
+}
+
 func Ok[T any](v T) Result[T] {
 	return Result[T]{value: v, err: nil}
 }
@@ -137,3 +257,75 @@ func OkZero[T any]() Result[T] {
 func Err[T any](err error) Result[T] {
 	return Result[T]{err: err}
 }
+
+// ResultFromTuple builds a Result from the idiomatic (value, error) return pair: Err if `err`
+// is not nil, Ok otherwise.
+func ResultFromTuple[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+
+	return Ok(v)
+}
+
+func TryMap[T, U any](r Result[T], fn func(T) (U, error)) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+
+	v, err := fn(r.value)
+	if err != nil {
+		return Err[U](err)
+	}
+
+	return Ok(v)
+}
+
+// FlatMapResult chains a fallible step that may change the value type. If `r` is Err, `fn` is
+// not called and the very same error is carried over, without any rewrapping, so errors.Is and
+// errors.As keep matching it down the chain.
+func FlatMapResult[T, U any](r Result[T], fn func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+
+	return fn(r.value)
+}
+
+// OptionFromResult converts a Result into an Option: Some on Ok, None on Err, discarding the
+// error. It is the free function form of OkOption.
+func OptionFromResult[T any](r Result[T]) Option[T] {
+	return r.OkOption()
+}
+
+// ResultFromOption converts an Option into a Result: Ok on Some, Err with `err` on None. It is
+// the free function form of Option.OkOr.
+func ResultFromOption[T any](o Option[T], err error) Result[T] {
+	return o.OkOr(err)
+}
+
+// ZipResult combines two Results into an Ok pair if both are Ok, otherwise returning the
+// first error found.
+func ZipResult[T, U any](a Result[T], b Result[U]) Result[tuples.Tuple2[T, U]] {
+	if a.err != nil {
+		return Err[tuples.Tuple2[T, U]](a.err)
+	}
+
+	if b.err != nil {
+		return Err[tuples.Tuple2[T, U]](b.err)
+	}
+
+	return Ok(tuples.Tuple2[T, U]{V1: a.value, V2: b.value})
+}
+
+// ResultOrEmpty unwraps a slice result, returning a non-nil empty slice on Err or when the
+// wrapped slice is nil. See OrEmpty for the Option counterpart.
+func ResultOrEmpty[T any](r Result[[]T]) []T {
+	return OrEmpty(r.OkOption())
+}
+
+// ResultOrEmptyMap unwraps a map result, returning a non-nil empty map on Err or when the
+// wrapped map is nil. See OrEmptyMap for the Option counterpart.
+func ResultOrEmptyMap[K comparable, V any](r Result[map[K]V]) map[K]V {
+	return OrEmptyMap(r.OkOption())
+}
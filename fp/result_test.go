@@ -2,6 +2,9 @@ package fp
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"strconv"
 	"testing"
 )
 
@@ -42,6 +45,27 @@ func TestResult(t *testing.T) {
 	_ = ok.UnwrapUnsafe()
 }
 
+func TestResult_Options(t *testing.T) {
+	ok := Ok(1)
+	fail := Err[int](io.EOF)
+
+	if value := ok.OkOption().UnwrapUnsafe(); value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	if ok.ErrOption().IsSome() {
+		t.Error("unexpected result, want none, have some")
+	}
+
+	if fail.OkOption().IsSome() {
+		t.Error("unexpected result, want none, have some")
+	}
+
+	if err := fail.ErrOption().UnwrapUnsafe(); !errors.Is(err, io.EOF) {
+		t.Errorf("unexpected err, want io.EOF, have %v", err)
+	}
+}
+
 func TestResult_Or(t *testing.T) {
 	ok := Ok(1)
 	fail := Err[int](errors.New("cannot divide by zero"))
@@ -208,6 +232,49 @@ func TestResult_AndThen(t *testing.T) {
 	}
 }
 
+func TestResult_AndThenValue(t *testing.T) {
+	ok := Ok(1)
+	fail := Err[int](errors.New("cannot divide by zero"))
+
+	value := ok.AndThenValue(func(x int) int { return x + 1 }).UnwrapUnsafe()
+
+	if value != 2 {
+		t.Errorf("unexpected result, want 2, have %d", value)
+	}
+
+	_, err := fail.AndThenValue(func(x int) int { return x + 1 }).Unwrap()
+	if err == nil {
+		t.Errorf("unexpected result, want err but have none")
+	}
+}
+
+func TestResult_AndThenTry(t *testing.T) {
+	errOdd := errors.New("odd number")
+	errDivision := errors.New("cannot divide by zero")
+	half := func(x int) Result[int] {
+		if x%2 != 0 {
+			return Err[int](errOdd)
+		}
+		return Ok(x / 2)
+	}
+
+	value := Ok(4).AndThenTry(half).AndThenTry(half).UnwrapUnsafe()
+
+	if value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	_, err := Ok(2).AndThenTry(half).AndThenTry(half).Unwrap()
+	if !errors.Is(err, errOdd) {
+		t.Errorf("unexpected err, want %v, have %v", errOdd, err)
+	}
+
+	_, err = Err[int](errDivision).AndThenTry(half).Unwrap()
+	if !errors.Is(err, errDivision) {
+		t.Errorf("unexpected err, want %v, have %v", errDivision, err)
+	}
+}
+
 func TestResult_Map(t *testing.T) {
 	ok := Ok(1)
 	fail := Err[int](errors.New("cannot divide by zero"))
@@ -271,3 +338,327 @@ func TestResult_MapOrElse(t *testing.T) {
 		t.Errorf("unexpected result, want 1, have %d", value)
 	}
 }
+
+func TestResult_Filter(t *testing.T) {
+	errNegative := errors.New("negative number")
+	errDivision := errors.New("cannot divide by zero")
+	positive := func(x int) bool { return x > 0 }
+
+	value := Ok(1).Filter(positive, errNegative).UnwrapUnsafe()
+
+	if value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	_, err := Ok(-1).Filter(positive, errNegative).Unwrap()
+	if !errors.Is(err, errNegative) {
+		t.Errorf("unexpected err, want %v, have %v", errNegative, err)
+	}
+
+	_, err = Err[int](errDivision).Filter(positive, errNegative).Unwrap()
+	if !errors.Is(err, errDivision) {
+		t.Errorf("unexpected err, want %v, have %v", errDivision, err)
+	}
+}
+
+func TestResult_FilterOrElse(t *testing.T) {
+	errNegative := errors.New("negative number")
+	errDivision := errors.New("cannot divide by zero")
+	positive := func(x int) bool { return x > 0 }
+	calls := 0
+	handleErr := func() error {
+		calls++
+		return errNegative
+	}
+
+	value := Ok(1).FilterOrElse(positive, handleErr).UnwrapUnsafe()
+
+	if value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	_, err := Err[int](errDivision).FilterOrElse(positive, handleErr).Unwrap()
+	if !errors.Is(err, errDivision) {
+		t.Errorf("unexpected err, want %v, have %v", errDivision, err)
+	}
+
+	if calls != 0 {
+		t.Errorf("unexpected error constructor calls, want 0, have %d", calls)
+	}
+
+	_, err = Ok(-1).FilterOrElse(positive, handleErr).Unwrap()
+	if !errors.Is(err, errNegative) {
+		t.Errorf("unexpected err, want %v, have %v", errNegative, err)
+	}
+
+	if calls != 1 {
+		t.Errorf("unexpected error constructor calls, want 1, have %d", calls)
+	}
+}
+
+func TestTryMap(t *testing.T) {
+	value := TryMap(Ok("1"), strconv.Atoi).UnwrapUnsafe()
+
+	if value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	_, err := TryMap(Ok("uno"), strconv.Atoi).Unwrap()
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("unexpected err, want %v, have %v", strconv.ErrSyntax, err)
+	}
+
+	fail := errors.New("cannot divide by zero")
+	called := false
+	_, err = TryMap(Err[string](fail), func(x string) (int, error) {
+		called = true
+		return strconv.Atoi(x)
+	}).Unwrap()
+
+	if !errors.Is(err, fail) {
+		t.Errorf("unexpected err, want %v, have %v", fail, err)
+	}
+
+	if called {
+		t.Error("unexpected call, fn should not be called on Err")
+	}
+}
+
+func TestFlatMapResult(t *testing.T) {
+	parse := func(x string) Result[int] { return ResultFromTuple(strconv.Atoi(x)) }
+	half := func(x int) Result[float64] {
+		if x%2 != 0 {
+			return Err[float64](errors.New("odd number"))
+		}
+		return Ok(float64(x) / 2)
+	}
+	format := func(x float64) Result[string] { return Ok(strconv.FormatFloat(x, 'f', 1, 64)) }
+
+	value := FlatMapResult(FlatMapResult(parse("4"), half), format).UnwrapUnsafe()
+
+	if value != "2.0" {
+		t.Errorf("unexpected result, want 2.0, have %s", value)
+	}
+
+	_, err := FlatMapResult(FlatMapResult(parse("3"), half), format).Unwrap()
+	if err == nil || err.Error() != "odd number" {
+		t.Errorf("unexpected err, want odd number, have %v", err)
+	}
+
+	fail := fmt.Errorf("loading config: %w", io.EOF)
+	calls := 0
+	count := func(x int) Result[int] { calls++; return Ok(x) }
+
+	chained := FlatMapResult(FlatMapResult(Err[int](fail), count), count)
+	_, err = FlatMapResult(chained, count).Unwrap()
+
+	if err != fail || !errors.Is(err, io.EOF) {
+		t.Errorf("unexpected err, want %v, have %v", fail, err)
+	}
+
+	if calls != 0 {
+		t.Errorf("unexpected calls, want 0, have %d", calls)
+	}
+}
+
+func TestResult_UnwrapOrZero(t *testing.T) {
+	if value := Ok(1).UnwrapOrZero(); value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	if value := Err[int](io.EOF).UnwrapOrZero(); value != 0 {
+		t.Errorf("unexpected result, want 0, have %d", value)
+	}
+}
+
+func TestResult_LazyVariants(t *testing.T) {
+	ok := Ok(1)
+	fail := Err[int](io.EOF)
+	calls := 0
+
+	_ = ok.OrElse(func() Result[int] { calls++; return Ok(2) })
+	_ = ok.UnwrapOrElse(func() int { calls++; return 2 })
+	_ = ok.MapOrElse(func(error) int { calls++; return 2 }, func(x int) int { return x })
+	_ = ok.FilterOrElse(func(int) bool { return true }, func() error { calls++; return io.EOF })
+	_ = fail.AndThen(func() int { calls++; return 2 })
+	_ = fail.AndThenValue(func(x int) int { calls++; return x })
+	_ = fail.AndThenTry(func(x int) Result[int] { calls++; return Ok(x) })
+
+	if calls != 0 {
+		t.Errorf("unexpected callback calls, want 0, have %d", calls)
+	}
+}
+
+func TestResult_Tuple(t *testing.T) {
+	value, err := ResultFromTuple(strconv.Atoi("1")).ToTuple()
+
+	if err != nil || value != 1 {
+		t.Errorf("unexpected result, want (1, nil), have (%d, %v)", value, err)
+	}
+
+	_, err = ResultFromTuple(strconv.Atoi("uno")).ToTuple()
+
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("unexpected err, want %v, have %v", strconv.ErrSyntax, err)
+	}
+
+	if !ResultFromTuple(Err[int](io.EOF).ToTuple()).IsErr() {
+		t.Error("unexpected result, want err, have ok")
+	}
+}
+
+func TestResult_Tap(t *testing.T) {
+	ok := Ok(1)
+	fail := Err[int](io.EOF)
+
+	var (
+		tapped    []int
+		tappedErr []error
+	)
+
+	tap := func(x int) { tapped = append(tapped, x) }
+	tapErr := func(err error) { tappedErr = append(tappedErr, err) }
+
+	value := ok.Tap(tap).
+		TapErr(tapErr).
+		Map(func(x int) int { return x + 1 }).
+		Tap(tap).
+		UnwrapUnsafe()
+
+	if value != 2 {
+		t.Errorf("unexpected result, want 2, have %d", value)
+	}
+
+	if len(tapped) != 2 || tapped[0] != 1 || tapped[1] != 2 || len(tappedErr) != 0 {
+		t.Errorf("unexpected taps, want [1 2] and no errors, have %v and %v", tapped, tappedErr)
+	}
+
+	tapped = nil
+
+	_, err := fail.Tap(tap).TapErr(tapErr).Unwrap()
+
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("unexpected err, want io.EOF, have %v", err)
+	}
+
+	if len(tapped) != 0 || len(tappedErr) != 1 || !errors.Is(tappedErr[0], io.EOF) {
+		t.Errorf("unexpected taps, want none and [EOF], have %v and %v", tapped, tappedErr)
+	}
+}
+
+func TestZipResult(t *testing.T) {
+	errA := errors.New("first failure")
+	errB := errors.New("second failure")
+
+	pair := ZipResult(Ok(1), Ok("uno")).UnwrapUnsafe()
+
+	if pair.V1 != 1 || pair.V2 != "uno" {
+		t.Errorf("unexpected result, want (1, uno), have (%d, %s)", pair.V1, pair.V2)
+	}
+
+	_, err := ZipResult(Err[int](errA), Ok("uno")).Unwrap()
+	if !errors.Is(err, errA) {
+		t.Errorf("unexpected err, want %v, have %v", errA, err)
+	}
+
+	_, err = ZipResult(Ok(1), Err[string](errB)).Unwrap()
+	if !errors.Is(err, errB) {
+		t.Errorf("unexpected err, want %v, have %v", errB, err)
+	}
+
+	_, err = ZipResult(Err[int](errA), Err[string](errB)).Unwrap()
+	if !errors.Is(err, errA) || errors.Is(err, errB) {
+		t.Errorf("unexpected err, want only %v, have %v", errA, err)
+	}
+}
+
+func TestResult_OrZero(t *testing.T) {
+	if value := Ok(1).OrZero(); value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	if value := Err[int](io.EOF).OrZero(); value != 0 {
+		t.Errorf("unexpected result, want 0, have %d", value)
+	}
+}
+
+func TestResultOrEmpty(t *testing.T) {
+	if value := ResultOrEmpty(Err[[]int](io.EOF)); value == nil || len(value) != 0 {
+		t.Errorf("unexpected result, want empty non-nil slice, have %v", value)
+	}
+
+	if value := ResultOrEmpty(Ok([]int{1})); len(value) != 1 {
+		t.Errorf("unexpected result, want [1], have %v", value)
+	}
+
+	if value := ResultOrEmptyMap(Err[map[string]int](io.EOF)); value == nil {
+		t.Error("unexpected result, want empty non-nil map, have nil")
+	}
+}
+
+func TestOptionResultConversion(t *testing.T) {
+	if value := OptionFromResult(Ok(1)).UnwrapUnsafe(); value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	if OptionFromResult(Err[int](io.EOF)).IsSome() {
+		t.Error("unexpected result, want none, have some")
+	}
+
+	if value := ResultFromOption(Some(1), io.EOF).UnwrapUnsafe(); value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+
+	_, err := ResultFromOption(None[int](), io.EOF).Unwrap()
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("unexpected err, want io.EOF, have %v", err)
+	}
+
+	roundTrip := OptionFromResult(ResultFromOption(Some("uno"), io.EOF))
+	if !EqualOptionComparable(roundTrip, Some("uno")) {
+		t.Errorf("unexpected result, want Some(uno), have %v", roundTrip)
+	}
+}
+
+func TestResult_Recover(t *testing.T) {
+	calls := 0
+	recoverFn := func(err error) int {
+		calls++
+		if errors.Is(err, io.EOF) {
+			return -1
+		}
+		return -2
+	}
+
+	if value := Ok(1).Recover(recoverFn).UnwrapUnsafe(); value != 1 || calls != 0 {
+		t.Errorf("unexpected result, want (1, 0 calls), have (%d, %d calls)", value, calls)
+	}
+
+	recovered := Err[int](fmt.Errorf("reading: %w", io.EOF)).Recover(recoverFn)
+
+	if !recovered.IsOk() {
+		t.Error("unexpected result, want ok, have err")
+	}
+
+	if value := recovered.UnwrapUnsafe(); value != -1 || calls != 1 {
+		t.Errorf("unexpected result, want (-1, 1 call), have (%d, %d calls)", value, calls)
+	}
+}
+
+func TestResult_Filter_NilErr(t *testing.T) {
+	positive := func(x int) bool { return x > 0 }
+
+	_, err := Ok(-1).Filter(positive, nil).Unwrap()
+	if !errors.Is(err, ErrFiltered) {
+		t.Errorf("unexpected err, want %v, have %v", ErrFiltered, err)
+	}
+
+	_, err = Ok(-1).FilterOrElse(positive, func() error { return nil }).Unwrap()
+	if !errors.Is(err, ErrFiltered) {
+		t.Errorf("unexpected err, want %v, have %v", ErrFiltered, err)
+	}
+
+	if value := Ok(1).Filter(positive, nil).UnwrapUnsafe(); value != 1 {
+		t.Errorf("unexpected result, want 1, have %d", value)
+	}
+}
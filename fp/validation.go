@@ -0,0 +1,21 @@
+package fp
+
+import "errors"
+
+// Validate runs every check against `value`, without stopping at the first failure. It returns
+// Ok(value) if all checks pass, or Err with all the failures joined through errors.Join.
+func Validate[T any](value T, checks ...func(T) error) Result[T] {
+	errs := make([]error, 0, len(checks))
+
+	for _, check := range checks {
+		if err := check(value); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return Err[T](errors.Join(errs...))
+	}
+
+	return Ok(value)
+}
@@ -0,0 +1,52 @@
+package fp
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	errEmpty := errors.New("empty name")
+	errLong := errors.New("name too long")
+	errLower := errors.New("name not capitalized")
+
+	notEmpty := func(x string) error {
+		if x == "" {
+			return errEmpty
+		}
+		return nil
+	}
+	short := func(x string) error {
+		if len(x) > 5 {
+			return errLong
+		}
+		return nil
+	}
+	capitalized := func(x string) error {
+		if strings.ToUpper(x[:1]) != x[:1] {
+			return errLower
+		}
+		return nil
+	}
+
+	value := Validate("Pepe", notEmpty, short, capitalized).UnwrapUnsafe()
+
+	if value != "Pepe" {
+		t.Errorf("unexpected result, want Pepe, have %s", value)
+	}
+
+	_, err := Validate("tombola", notEmpty, short, capitalized).Unwrap()
+
+	if !errors.Is(err, errLong) || !errors.Is(err, errLower) {
+		t.Errorf("unexpected err, want both %v and %v, have %v", errLong, errLower, err)
+	}
+
+	if errors.Is(err, errEmpty) {
+		t.Errorf("unexpected err, want no %v, have %v", errEmpty, err)
+	}
+
+	if Validate(1).IsErr() {
+		t.Error("unexpected result, want ok without checks, have err")
+	}
+}
@@ -2,6 +2,9 @@
 package maps
 
 import (
+	"sort"
+
+	"github.com/sonirico/stadio/constraints"
 	"github.com/sonirico/stadio/fp"
 	"github.com/sonirico/stadio/slices"
 	"github.com/sonirico/stadio/tuples"
@@ -35,6 +38,11 @@ func Equals[K comparable, V any](m1, m2 map[K]V, eq func(V, V) bool) bool {
 	return true
 }
 
+// EqualComparable is like Equals, comparing the values with ==.
+func EqualComparable[K, V comparable](m1, m2 map[K]V) bool {
+	return Equals(m1, m2, func(x, y V) bool { return x == y })
+}
+
 // Map transforms a map into another one, with same or different types
 func Map[K1 comparable, V1 any, K2 comparable, V2 any](
 	m map[K1]V1,
@@ -54,6 +62,22 @@ func Map[K1 comparable, V1 any, K2 comparable, V2 any](
 	return res
 }
 
+// TransformValues builds a new map with the same keys, deriving each value from both the key
+// and the former value.
+func TransformValues[K comparable, V1, V2 any](m map[K]V1, p func(K, V1) V2) map[K]V2 {
+	if m == nil {
+		return nil
+	}
+
+	res := make(map[K]V2, len(m))
+
+	for k, v := range m {
+		res[k] = p(k, v)
+	}
+
+	return res
+}
+
 // FilterMap both filters and maps a map. The predicate function should return a fp.Option monad:
 // fp.Some to indicate the entry should be kept.
 // fp.None to indicate the entry should be discarded
@@ -120,6 +144,40 @@ func Filter[K comparable, V any](
 	return res
 }
 
+// FilterKeys returns the keys of those entries matching predicate, in no particular order. A
+// nil map yields an empty slice.
+func FilterKeys[K comparable, V any](
+	m map[K]V,
+	p func(K, V) bool,
+) []K {
+	res := make([]K, 0, len(m))
+
+	for k, v := range m {
+		if p(k, v) {
+			res = append(res, k)
+		}
+	}
+
+	return res
+}
+
+// FilterValues returns the values of those entries matching predicate, in no particular order.
+// A nil map yields an empty slice.
+func FilterValues[K comparable, V any](
+	m map[K]V,
+	p func(K, V) bool,
+) []V {
+	res := make([]V, 0, len(m))
+
+	for k, v := range m {
+		if p(k, v) {
+			res = append(res, v)
+		}
+	}
+
+	return res
+}
+
 // FilterInPlace deletes those entries from the map that do not match predicate.
 func FilterInPlace[K comparable, V any](
 	m map[K]V,
@@ -175,6 +233,131 @@ func Fold[K comparable, V any, R any](
 	return r
 }
 
+// ReduceGroups folds every group of values independently, starting from `initial`, into a map
+// holding one result per key. It pairs with slices.GroupBy to aggregate each group in one go.
+// A nil map yields an empty one.
+func ReduceGroups[K comparable, V, R any](
+	groups map[K][]V,
+	p func(R, V) R,
+	initial R,
+) map[K]R {
+	res := make(map[K]R, len(groups))
+
+	for k, values := range groups {
+		res[k] = slices.Fold(values, p, initial)
+	}
+
+	return res
+}
+
+// ReduceSorted compacts the given map into a single type, visiting entries in ascending key
+// order so that the result is deterministic. Only maps keyed by ordered types are supported.
+func ReduceSorted[K constraints.Ordered, V any, R any](
+	m map[K]V,
+	p func(R, K, V) R,
+) R {
+	var r R
+	return FoldSorted(m, p, r)
+}
+
+// FoldSorted compacts the given map into a single type by taking into account the initial
+// value, visiting entries in ascending key order so that the result is deterministic. Only maps
+// keyed by ordered types are supported.
+func FoldSorted[K constraints.Ordered, V any, R any](
+	m map[K]V,
+	p func(R, K, V) R,
+	initial R,
+) R {
+	r := initial
+
+	for _, k := range sortedKeys(m) {
+		r = p(r, k, m[k])
+	}
+
+	return r
+}
+
+func sortedKeys[K constraints.Ordered, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	return keys
+}
+
+// Some returns whether at least one entry of the map matches predicate. Empty maps yield false.
+func Some[K comparable, V any](m map[K]V, p func(K, V) bool) bool {
+	for k, v := range m {
+		if p(k, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Every returns whether all entries of the map match predicate. Empty maps yield true.
+func Every[K comparable, V any](m map[K]V, p func(K, V) bool) bool {
+	for k, v := range m {
+		if !p(k, v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// None returns whether no entry of the map matches predicate. Empty maps yield true.
+func None[K comparable, V any](m map[K]V, p func(K, V) bool) bool {
+	return !Some(m, p)
+}
+
+// FindEntry returns the first entry that matches predicate, wrapped in fp.Some, or fp.None if
+// there is no such entry. As map iteration is unordered, which entry is "first" is not
+// deterministic when several of them match.
+func FindEntry[K comparable, V any](
+	m map[K]V,
+	p func(K, V) bool,
+) fp.Option[tuples.Tuple2[K, V]] {
+	for k, v := range m {
+		if p(k, v) {
+			return fp.Some(tuples.Tuple2[K, V]{V1: k, V2: v})
+		}
+	}
+
+	return fp.None[tuples.Tuple2[K, V]]()
+}
+
+// FirstKeyWhere returns a key whose entry matches predicate, wrapped in fp.Some, or fp.None if
+// there is no such entry. As map iteration is unordered, which key is returned is not
+// deterministic when several entries match.
+func FirstKeyWhere[K comparable, V any](m map[K]V, p func(K, V) bool) fp.Option[K] {
+	for k, v := range m {
+		if p(k, v) {
+			return fp.Some(k)
+		}
+	}
+
+	return fp.None[K]()
+}
+
+// FirstValueWhere returns a value whose entry matches predicate, wrapped in fp.Some, or fp.None
+// if there is no such entry. As map iteration is unordered, which value is returned is not
+// deterministic when several entries match.
+func FirstValueWhere[K comparable, V any](m map[K]V, p func(K, V) bool) fp.Option[V] {
+	for k, v := range m {
+		if p(k, v) {
+			return fp.Some(v)
+		}
+	}
+
+	return fp.None[V]()
+}
+
 // Slice converts a map into a slice
 func Slice[K comparable, V, R any](
 	m map[K]V,
@@ -190,3 +373,39 @@ func Slice[K comparable, V, R any](
 
 	return res
 }
+
+// ToSortedSlice converts a map into a slice, visiting entries in ascending key order so that
+// the output is deterministic. Only maps keyed by ordered types are supported.
+func ToSortedSlice[K constraints.Ordered, V, R any](
+	m map[K]V,
+	p func(K, V) R,
+) slices.Slice[R] {
+	keys := sortedKeys(m)
+	res := make([]R, len(keys))
+
+	for i, k := range keys {
+		res[i] = p(k, m[k])
+	}
+
+	return res
+}
+
+// CountValues counts how many keys hold each distinct value of the map. Nil maps yield an empty
+// map.
+func CountValues[K, V comparable](m map[K]V) map[V]int {
+	res := make(map[V]int)
+
+	for _, v := range m {
+		res[v]++
+	}
+
+	return res
+}
+
+// Update reads the value stored under `key`, or the zero value if absent, and stores back the
+// result of `fn`, which is also told whether the key existed. The given map is mutated, hence
+// it must not be nil.
+func Update[K comparable, V any](m map[K]V, key K, fn func(old V, existed bool) V) {
+	old, ok := m[key]
+	m[key] = fn(old, ok)
+}
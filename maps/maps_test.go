@@ -1,13 +1,60 @@
 package maps
 
 import (
+	"sort"
 	"strconv"
 	"testing"
 
 	"github.com/sonirico/stadio/fp"
+	"github.com/sonirico/stadio/slices"
 	"github.com/sonirico/stadio/tuples"
 )
 
+func TestEqualComparable(t *testing.T) {
+	type (
+		testCase struct {
+			name     string
+			m1, m2   map[string]int
+			expected bool
+		}
+	)
+
+	tests := []testCase{
+		{
+			name:     "nil maps are equal",
+			m1:       nil,
+			m2:       nil,
+			expected: true,
+		},
+		{
+			name:     "equal maps",
+			m1:       map[string]int{"a": 1, "b": 2},
+			m2:       map[string]int{"b": 2, "a": 1},
+			expected: true,
+		},
+		{
+			name:     "differing value",
+			m1:       map[string]int{"a": 1, "b": 2},
+			m2:       map[string]int{"a": 1, "b": 3},
+			expected: false,
+		},
+		{
+			name:     "differing key set",
+			m1:       map[string]int{"a": 1, "b": 2},
+			m2:       map[string]int{"a": 1, "c": 2},
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := EqualComparable(test.m1, test.m2); actual != test.expected {
+				t.Errorf("unexpected value, want %t, have %t", test.expected, actual)
+			}
+		})
+	}
+}
+
 func TestMapTo(t *testing.T) {
 	type (
 		testCase struct {
@@ -51,6 +98,49 @@ func TestMapTo(t *testing.T) {
 	}
 }
 
+func TestTransformValues(t *testing.T) {
+	type (
+		testCase struct {
+			name     string
+			payload  map[int]int
+			expected map[int]string
+		}
+	)
+
+	tests := []testCase{
+		{
+			name:     "nil map is noop",
+			payload:  nil,
+			expected: nil,
+		},
+		{
+			name:     "empty map returns empty map",
+			payload:  map[int]int{},
+			expected: map[int]string{},
+		},
+		{
+			name:     "values depend on keys",
+			payload:  map[int]int{1: 3, 2: 3},
+			expected: map[int]string{1: "3", 2: "6"},
+		},
+	}
+
+	predicate := func(k, v int) string {
+		return strconv.Itoa(k * v)
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := TransformValues(test.payload, predicate)
+
+			if !Equals(test.expected, actual, assertMapValueEq) {
+				t.Errorf("unexpected map\nwant %v\nhave %v",
+					test.expected, actual)
+			}
+		})
+	}
+}
+
 func TestFilterMap(t *testing.T) {
 	type (
 		testCase struct {
@@ -84,8 +174,8 @@ func TestFilterMap(t *testing.T) {
 		}
 
 		return fp.Some(tuples.Tuple2[string, string]{
-			strconv.FormatInt(int64(k), 10),
-			strconv.FormatInt(int64(v*v), 10),
+			V1: strconv.FormatInt(int64(k), 10),
+			V2: strconv.FormatInt(int64(v*v), 10),
 		})
 	}
 
@@ -144,6 +234,62 @@ func TestFilter(t *testing.T) {
 	}
 }
 
+func TestFilterKeysValues(t *testing.T) {
+	type (
+		testCase struct {
+			name           string
+			payload        map[int]int
+			expectedKeys   []int
+			expectedValues []int
+		}
+	)
+
+	tests := []testCase{
+		{
+			name:           "nil map returns empty",
+			payload:        nil,
+			expectedKeys:   []int{},
+			expectedValues: []int{},
+		},
+		{
+			name:           "no entry matches",
+			payload:        map[int]int{1: 10, 3: 30},
+			expectedKeys:   []int{},
+			expectedValues: []int{},
+		},
+		{
+			name:           "some entries match",
+			payload:        map[int]int{1: 10, 2: 20, 3: 30, 4: 40},
+			expectedKeys:   []int{2, 4},
+			expectedValues: []int{20, 40},
+		},
+	}
+
+	predicate := func(k, v int) bool {
+		return k%2 == 0
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			keys := FilterKeys(test.payload, predicate)
+			values := FilterValues(test.payload, predicate)
+
+			sort.Ints(keys)
+			sort.Ints(values)
+
+			eq := func(x, y int) bool { return x == y }
+
+			if keys == nil || !slices.Equals(test.expectedKeys, keys, eq) {
+				t.Errorf("unexpected keys, want %v, have %v", test.expectedKeys, keys)
+			}
+
+			if values == nil || !slices.Equals(test.expectedValues, values, eq) {
+				t.Errorf("unexpected values, want %v, have %v", test.expectedValues, values)
+			}
+		})
+	}
+}
+
 func TestFilterInPlace(t *testing.T) {
 	type (
 		testCase struct {
@@ -273,6 +419,301 @@ func TestFold(t *testing.T) {
 	}
 }
 
+func TestReduceGroups(t *testing.T) {
+	parity := func(x int) string {
+		if x%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	type (
+		testCase struct {
+			name     string
+			payload  map[string][]int
+			expected map[string]int
+		}
+	)
+
+	tests := []testCase{
+		{
+			name:     "nil map yields empty",
+			payload:  nil,
+			expected: map[string]int{},
+		},
+		{
+			name:     "empty group yields initial",
+			payload:  map[string][]int{"a": nil},
+			expected: map[string]int{"a": 0},
+		},
+		{
+			name:     "groups are summed per key",
+			payload:  slices.GroupBy([]int{1, 2, 3, 4, 5}, parity),
+			expected: map[string]int{"even": 6, "odd": 9},
+		},
+	}
+
+	sum := func(acc, x int) int {
+		return acc + x
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := ReduceGroups(test.payload, sum, 0)
+
+			if actual == nil || !EqualComparable(test.expected, actual) {
+				t.Errorf("unexpected map\nwant %v\nhave %v",
+					test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestReduceSorted(t *testing.T) {
+	type (
+		testCase struct {
+			name     string
+			payload  map[int]string
+			expected string
+		}
+	)
+
+	tests := []testCase{
+		{
+			name:     "nil map yields zero value",
+			payload:  nil,
+			expected: "",
+		},
+		{
+			name:     "empty map returns zero value",
+			payload:  map[int]string{},
+			expected: "",
+		},
+		{
+			name:     "filled map is visited in key order",
+			payload:  map[int]string{3: "c", 1: "a", 4: "d", 2: "b", 5: "e"},
+			expected: "abcde",
+		},
+	}
+
+	predicate := func(acc string, _ int, v string) string {
+		return acc + v
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := ReduceSorted(test.payload, predicate)
+
+			if test.expected != actual {
+				t.Errorf("unexpected map reduce result. \nwant %v\nhave %v",
+					test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFoldSorted(t *testing.T) {
+	payload := map[string]int{"b": 2, "c": 3, "a": 1}
+
+	actual := FoldSorted(payload, func(acc string, k string, v int) string {
+		return acc + k + strconv.Itoa(v)
+	}, ">")
+
+	expected := ">a1b2c3"
+
+	if expected != actual {
+		t.Errorf("unexpected map fold result. \nwant %v\nhave %v", expected, actual)
+	}
+}
+
+func TestSomeEveryNone(t *testing.T) {
+	type (
+		testCase struct {
+			name          string
+			payload       map[int]int
+			expectedSome  bool
+			expectedEvery bool
+			expectedNone  bool
+		}
+	)
+
+	tests := []testCase{
+		{
+			name:          "nil map",
+			payload:       nil,
+			expectedSome:  false,
+			expectedEvery: true,
+			expectedNone:  true,
+		},
+		{
+			name:          "empty map",
+			payload:       map[int]int{},
+			expectedSome:  false,
+			expectedEvery: true,
+			expectedNone:  true,
+		},
+		{
+			name:          "all entries match",
+			payload:       map[int]int{1: 2, 2: 4},
+			expectedSome:  true,
+			expectedEvery: true,
+			expectedNone:  false,
+		},
+		{
+			name:          "mixed entries",
+			payload:       map[int]int{1: 2, 2: 3},
+			expectedSome:  true,
+			expectedEvery: false,
+			expectedNone:  false,
+		},
+		{
+			name:          "no entry matches",
+			payload:       map[int]int{1: 3, 2: 5},
+			expectedSome:  false,
+			expectedEvery: false,
+			expectedNone:  true,
+		},
+	}
+
+	predicate := func(_, v int) bool {
+		return v%2 == 0
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := Some(test.payload, predicate); test.expectedSome != actual {
+				t.Errorf("unexpected Some result, want %t, have %t", test.expectedSome, actual)
+			}
+			if actual := Every(test.payload, predicate); test.expectedEvery != actual {
+				t.Errorf("unexpected Every result, want %t, have %t", test.expectedEvery, actual)
+			}
+			if actual := None(test.payload, predicate); test.expectedNone != actual {
+				t.Errorf("unexpected None result, want %t, have %t", test.expectedNone, actual)
+			}
+		})
+	}
+}
+
+func TestFindEntry(t *testing.T) {
+	payload := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	entry, ok := FindEntry(payload, func(_ string, v int) bool {
+		return v == 2
+	}).Unwrap()
+
+	if !ok {
+		t.Fatalf("unexpected result, want some, have none")
+	}
+
+	if entry.V1 != "b" || entry.V2 != 2 {
+		t.Errorf("unexpected entry, want (b, 2), have (%s, %d)", entry.V1, entry.V2)
+	}
+
+	notFound := FindEntry(payload, func(_ string, v int) bool {
+		return v > 3
+	})
+
+	if notFound.IsSome() {
+		t.Errorf("unexpected result, want none, have some")
+	}
+
+	if FindEntry[string, int](nil, func(string, int) bool { return true }).IsSome() {
+		t.Errorf("unexpected result on nil map, want none, have some")
+	}
+}
+
+func TestToSortedSlice(t *testing.T) {
+	payload := map[int]string{3: "c", 1: "a", 2: "b"}
+
+	actual := ToSortedSlice(payload, func(k int, v string) string {
+		return strconv.Itoa(k) + v
+	})
+	expected := []string{"1a", "2b", "3c"}
+
+	if !actual.Equals(expected, assertMapValueEq) {
+		t.Errorf("unexpected slice\nwant %v\nhave %v", expected, actual)
+	}
+
+	empty := ToSortedSlice(map[int]string(nil), func(int, string) int { return 0 })
+	if len(empty) != 0 {
+		t.Errorf("unexpected slice, want empty, have %v", empty)
+	}
+}
+
+func TestCountValues(t *testing.T) {
+	actual := CountValues(map[string]int{"a": 1, "b": 2, "c": 1, "d": 1})
+
+	if len(actual) != 2 || actual[1] != 3 || actual[2] != 1 {
+		t.Errorf("unexpected histogram, want map[1:3 2:1], have %v", actual)
+	}
+
+	if empty := CountValues[string, int](nil); empty == nil || len(empty) != 0 {
+		t.Errorf("unexpected histogram, want empty map, have %v", empty)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	payload := map[string]int{"a": 1}
+	increment := func(old int, _ bool) int { return old + 1 }
+
+	Update(payload, "a", increment)
+	Update(payload, "b", increment)
+
+	if payload["a"] != 2 || payload["b"] != 1 {
+		t.Errorf("unexpected map, want map[a:2 b:1], have %v", payload)
+	}
+
+	existed := make(map[string]bool)
+	for _, k := range []string{"a", "c"} {
+		Update(payload, k, func(old int, ok bool) int {
+			existed[k] = ok
+			return old
+		})
+	}
+
+	if !existed["a"] || existed["c"] {
+		t.Errorf("unexpected existence flags, want map[a:true c:false], have %v", existed)
+	}
+}
+
+func TestFirstKeyWhere(t *testing.T) {
+	payload := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+
+	key := FirstKeyWhere(payload, func(_ string, v int) bool { return v == 2 })
+	if expected := fp.Some("b"); expected != key {
+		t.Errorf("unexpected key, want %v, have %v", expected, key)
+	}
+
+	key = FirstKeyWhere(payload, func(_ string, v int) bool { return v%2 == 0 })
+	if k, ok := key.Unwrap(); !ok || (k != "b" && k != "d") {
+		t.Errorf("unexpected key, want any of b or d, have %v", key)
+	}
+
+	key = FirstKeyWhere(payload, func(_ string, v int) bool { return v > 4 })
+	if key.IsSome() {
+		t.Errorf("unexpected key, want none, have %v", key)
+	}
+}
+
+func TestFirstValueWhere(t *testing.T) {
+	payload := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+
+	value := FirstValueWhere(payload, func(k string, _ int) bool { return k == "c" })
+	if expected := fp.Some(3); expected != value {
+		t.Errorf("unexpected value, want %v, have %v", expected, value)
+	}
+
+	value = FirstValueWhere(payload, func(k string, _ int) bool { return k > "b" })
+	if v, ok := value.Unwrap(); !ok || (v != 3 && v != 4) {
+		t.Errorf("unexpected value, want any of 3 or 4, have %v", value)
+	}
+
+	value = FirstValueWhere(payload, func(k string, _ int) bool { return k == "z" })
+	if value.IsSome() {
+		t.Errorf("unexpected value, want none, have %v", value)
+	}
+}
+
 func assertMapValueEq(x, y string) bool {
 	return x == y
 }
@@ -0,0 +1,43 @@
+package slices
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+func (s Slice[T]) WriteJSON(w io.Writer) error {
+	return WriteJSON(w, s)
+}
+
+// WriteJSON streams the slice to `w` as a JSON array, encoding one element at a time, so that
+// only the largest element is ever buffered rather than the whole document. The output matches
+// json.Marshal, except that nil slices are written as [] instead of null.
+func WriteJSON[T any](w io.Writer, arr []T) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	for i, x := range arr {
+		buf.Reset()
+
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		if err := enc.Encode(x); err != nil {
+			return err
+		}
+
+		// Encode terminates every value with a newline, which json.Marshal does not.
+		if _, err := w.Write(bytes.TrimSuffix(buf.Bytes(), []byte{'\n'})); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "]")
+	return err
+}
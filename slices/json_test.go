@@ -0,0 +1,104 @@
+package slices
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type countingWriter struct {
+	writes  int
+	largest int
+	buf     bytes.Buffer
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	if len(p) > w.largest {
+		w.largest = len(p)
+	}
+	return w.buf.Write(p)
+}
+
+func TestWriteJSON(t *testing.T) {
+	type record struct {
+		Name string `json:"name"`
+		Tags []string
+	}
+
+	type testCase struct {
+		name     string
+		payload  Slice[record]
+		expected string
+	}
+
+	tests := []testCase{
+		{
+			name:     "nil slice is written as empty array",
+			payload:  nil,
+			expected: "[]",
+		},
+		{
+			name:     "empty slice",
+			payload:  Slice[record]{},
+			expected: "[]",
+		},
+		{
+			name: "matches json.Marshal",
+			payload: Slice[record]{
+				{Name: "<a>", Tags: []string{"x"}},
+				{Name: "b\n"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expected := test.expected
+			if expected == "" {
+				raw, _ := json.Marshal(test.payload)
+				expected = string(raw)
+			}
+
+			var buf bytes.Buffer
+			if err := test.payload.WriteJSON(&buf); err != nil {
+				t.Fatalf("unexpected err, want nil, have %v", err)
+			}
+
+			if buf.String() != expected {
+				t.Errorf("unexpected value, want %s, have %s", expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestWriteJSON_Streaming(t *testing.T) {
+	payload := make([]int, 1000)
+	for i := range payload {
+		payload[i] = i
+	}
+
+	var w countingWriter
+	if err := WriteJSON(&w, payload); err != nil {
+		t.Fatalf("unexpected err, want nil, have %v", err)
+	}
+
+	if w.writes != len(payload)+2 {
+		t.Errorf("unexpected writes, want %d, have %d", len(payload)+2, w.writes)
+	}
+
+	if w.largest > 4 {
+		t.Errorf("unexpected write size, want at most one element, have %d bytes", w.largest)
+	}
+
+	if raw, _ := json.Marshal(payload); w.buf.String() != string(raw) {
+		t.Errorf("unexpected value, want json.Marshal output")
+	}
+
+	err := WriteJSON(&w, []any{func() {}})
+	var unsupported *json.UnsupportedTypeError
+	if !errors.As(err, &unsupported) {
+		t.Errorf("unexpected err, want %T, have %v", unsupported, err)
+	}
+}
@@ -0,0 +1,188 @@
+package slices
+
+import (
+	"sort"
+
+	"github.com/sonirico/stadio/constraints"
+	"github.com/sonirico/stadio/fp"
+)
+
+type (
+	// OrderedSlice is the counterpart of Slice for ordered types, exposing the methods that
+	// require comparing elements. Both are plain slices, so conversions are free:
+	// OrderedSlice[int](s) and Slice[int](o).
+	OrderedSlice[T constraints.Ordered] []T
+)
+
+func (s OrderedSlice[T]) Slice() Slice[T] {
+	return Slice[T](s)
+}
+
+func (s OrderedSlice[T]) Len() int {
+	return len(s)
+}
+
+func (s OrderedSlice[T]) Sort() OrderedSlice[T] {
+	return Sort(s)
+}
+
+func (s OrderedSlice[T]) Unique() OrderedSlice[T] {
+	return Unique(s)
+}
+
+func (s OrderedSlice[T]) Max() (T, bool) {
+	return Max(s)
+}
+
+func (s OrderedSlice[T]) Min() (T, bool) {
+	return Min(s)
+}
+
+func (s OrderedSlice[T]) Contains(item T) bool {
+	return Includes(s, item)
+}
+
+// Sort sorts the slice in place in ascending order and returns it.
+func Sort[T constraints.Ordered](arr []T) []T {
+	sort.Slice(arr, func(i, j int) bool { return arr[i] < arr[j] })
+	return arr
+}
+
+// SortedInsert inserts `item` into the ascending sorted slice, keeping it sorted. The position
+// is found by binary search, and equal elements keep their insertion order, `item` going after
+// them. Like Insert, the input's backing array is reused when its capacity allows.
+func SortedInsert[T constraints.Ordered](arr []T, item T) []T {
+	return SortedInsertFunc(arr, item, func(x, y T) bool { return x < y })
+}
+
+// SortedInsertFunc is like SortedInsert, for slices sorted according to `less`.
+func SortedInsertFunc[T any](arr []T, item T, less func(x, y T) bool) []T {
+	idx := sort.Search(len(arr), func(i int) bool { return less(item, arr[i]) })
+
+	var zero T
+	arr = append(arr, zero)
+	copy(arr[idx+1:], arr[idx:])
+	arr[idx] = item
+
+	return arr
+}
+
+// Unique returns a new slice without duplicates, keeping the first occurrence of each element.
+func Unique[T comparable](arr []T) []T {
+	seen := make(map[T]struct{}, len(arr))
+	res := make([]T, 0, len(arr))
+
+	for _, x := range arr {
+		if _, ok := seen[x]; ok {
+			continue
+		}
+
+		seen[x] = struct{}{}
+		res = append(res, x)
+	}
+
+	return res
+}
+
+// UniqueLast returns a new slice without duplicates, keeping the last occurrence of each
+// element, in the order of those last occurrences. E.g: UniqueLast([1, 2, 1, 3]) -> [2, 1, 3],
+// whereas Unique yields [1, 2, 3].
+func UniqueLast[T comparable](arr []T) []T {
+	return UniqueByLast(arr, func(x T) T { return x })
+}
+
+// UniqueByLast is like UniqueLast, but two elements are considered duplicates when `key`
+// returns the same value for both. Useful to keep the latest record of every entity.
+func UniqueByLast[T any, K comparable](arr []T, key func(T) K) []T {
+	seen := make(map[K]struct{}, len(arr))
+	res := make([]T, 0, len(arr))
+
+	for i := len(arr) - 1; i >= 0; i-- {
+		k := key(arr[i])
+		if _, ok := seen[k]; ok {
+			continue
+		}
+
+		seen[k] = struct{}{}
+		res = append(res, arr[i])
+	}
+
+	reverse(res)
+
+	return res
+}
+
+// Max returns the greatest element of the slice, and false if the slice is empty.
+func Max[T constraints.Ordered](arr []T) (res T, ok bool) {
+	idx := MaxIndex(arr)
+	if idx < 0 {
+		return
+	}
+
+	return arr[idx], true
+}
+
+// Min returns the lowest element of the slice, and false if the slice is empty.
+func Min[T constraints.Ordered](arr []T) (res T, ok bool) {
+	idx := MinIndex(arr)
+	if idx < 0 {
+		return
+	}
+
+	return arr[idx], true
+}
+
+// MaxOption is the Option counterpart of Max, returning None if the slice is empty.
+func MaxOption[T constraints.Ordered](arr []T) fp.Option[T] {
+	return optionAt(arr, MaxIndex(arr))
+}
+
+// MinOption is the Option counterpart of Min, returning None if the slice is empty.
+func MinOption[T constraints.Ordered](arr []T) fp.Option[T] {
+	return optionAt(arr, MinIndex(arr))
+}
+
+// MaxByOption returns the greatest element according to `less`, or None if the slice is empty.
+// On ties, the first element wins.
+func MaxByOption[T any](arr []T, less func(x, y T) bool) fp.Option[T] {
+	return optionAt(arr, MaxIndexBy(arr, less))
+}
+
+// MinByOption returns the lowest element according to `less`, or None if the slice is empty.
+// On ties, the first element wins.
+func MinByOption[T any](arr []T, less func(x, y T) bool) fp.Option[T] {
+	return optionAt(arr, MinIndexBy(arr, less))
+}
+
+func optionAt[T any](arr []T, idx int) fp.Option[T] {
+	if idx < 0 {
+		return fp.None[T]()
+	}
+
+	return fp.Some(arr[idx])
+}
+
+// Includes returns whether `item` is an element of the slice.
+func Includes[T comparable](arr []T, item T) bool {
+	return IndexOf(arr, func(x T) bool { return x == item }) >= 0
+}
+
+// Clamp bounds `v` to the closed range [lo, hi]. If `lo` is greater than `hi`, `lo` is
+// returned.
+func Clamp[T constraints.Ordered](v, lo, hi T) T {
+	if lo > hi || v < lo {
+		return lo
+	}
+
+	if v > hi {
+		return hi
+	}
+
+	return v
+}
+
+// ClampEach returns a new slice with every element bounded to the closed range [lo, hi], see
+// Clamp.
+func ClampEach[T constraints.Ordered](arr []T, lo, hi T) []T {
+	return Map(arr, func(x T) T { return Clamp(x, lo, hi) })
+}
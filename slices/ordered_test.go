@@ -0,0 +1,242 @@
+package slices
+
+import (
+	"testing"
+)
+
+func TestOrderedSlice(t *testing.T) {
+	payload := Slice[int]([]int{3, 1, 2, 3, 1}).
+		Filter(func(x int) bool { return x > 0 })
+
+	actual := OrderedSlice[int](payload).Unique().Sort()
+	expected := []int{1, 2, 3}
+
+	if !Equals(expected, actual, testArrEq) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+
+	if max, ok := actual.Max(); !ok || max != 3 {
+		t.Errorf("unexpected max, want (3, true), have (%d, %t)", max, ok)
+	}
+
+	if min, ok := actual.Min(); !ok || min != 1 {
+		t.Errorf("unexpected min, want (1, true), have (%d, %t)", min, ok)
+	}
+
+	if !actual.Contains(2) || actual.Contains(4) {
+		t.Errorf("unexpected contains result on %v", actual)
+	}
+
+	if actual.Slice().Len() != 3 {
+		t.Errorf("unexpected length, want 3, have %d", actual.Slice().Len())
+	}
+}
+
+func TestOrderedSlice_Empty(t *testing.T) {
+	var empty OrderedSlice[string]
+
+	if _, ok := empty.Max(); ok {
+		t.Errorf("unexpected max on empty slice")
+	}
+
+	if _, ok := empty.Min(); ok {
+		t.Errorf("unexpected min on empty slice")
+	}
+
+	if empty.Contains("") {
+		t.Errorf("unexpected contains on empty slice")
+	}
+
+	if actual := empty.Sort().Unique(); len(actual) != 0 {
+		t.Errorf("unexpected value, want empty slice, have %v", actual)
+	}
+}
+
+func TestMinMaxOption(t *testing.T) {
+	type pair struct {
+		name string
+		age  int
+	}
+
+	byAge := func(x, y pair) bool { return x.age < y.age }
+
+	if MaxOption([]int{}).IsSome() || MinOption[int](nil).IsSome() {
+		t.Errorf("unexpected some on empty slice")
+	}
+
+	if MaxByOption([]pair{}, byAge).IsSome() || MinByOption[pair](nil, byAge).IsSome() {
+		t.Errorf("unexpected some on empty slice")
+	}
+
+	if actual := MaxOption([]int{2, 5, 1}).UnwrapUnsafe(); actual != 5 {
+		t.Errorf("unexpected value, want 5, have %d", actual)
+	}
+
+	if actual := MinOption([]int{2, 5, 1}).UnwrapUnsafe(); actual != 1 {
+		t.Errorf("unexpected value, want 1, have %d", actual)
+	}
+
+	people := []pair{{"ana", 30}, {"bob", 20}, {"eva", 30}, {"ian", 20}}
+
+	if actual := MaxByOption(people, byAge).UnwrapUnsafe(); actual.name != "ana" {
+		t.Errorf("unexpected value, want ana, have %s", actual.name)
+	}
+
+	if actual := MinByOption(people, byAge).UnwrapUnsafe(); actual.name != "bob" {
+		t.Errorf("unexpected value, want bob, have %s", actual.name)
+	}
+}
+
+func TestUnique(t *testing.T) {
+	actual := Unique([]string{"b", "a", "b", "c", "a"})
+	expected := []string{"b", "a", "c"}
+
+	if !Equals(expected, actual, func(x, y string) bool { return x == y }) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+}
+
+func TestSortedInsert(t *testing.T) {
+	type testCase struct {
+		name     string
+		payload  []int
+		item     int
+		expected []int
+	}
+
+	tests := []testCase{
+		{
+			name:     "nil slice",
+			payload:  nil,
+			item:     1,
+			expected: []int{1},
+		},
+		{
+			name:     "insert at front",
+			payload:  []int{2, 3},
+			item:     1,
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "insert in the middle",
+			payload:  []int{1, 3, 5},
+			item:     4,
+			expected: []int{1, 3, 4, 5},
+		},
+		{
+			name:     "insert at end",
+			payload:  []int{1, 2},
+			item:     3,
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "insert duplicate",
+			payload:  []int{1, 2, 2, 3},
+			item:     2,
+			expected: []int{1, 2, 2, 2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := SortedInsert(test.payload, test.item)
+
+			if !Equals(test.expected, actual, testArrEq) {
+				t.Errorf("unexpected value, want %v, have %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSortedInsertFunc(t *testing.T) {
+	type record struct {
+		key int
+		tag string
+	}
+
+	byKey := func(x, y record) bool { return x.key < y.key }
+
+	var actual []record
+	for _, r := range []record{{2, "a"}, {1, "b"}, {2, "c"}, {3, "d"}, {1, "e"}} {
+		actual = SortedInsertFunc(actual, r, byKey)
+	}
+
+	expected := []record{{1, "b"}, {1, "e"}, {2, "a"}, {2, "c"}, {3, "d"}}
+
+	if !Equals(expected, actual, func(x, y record) bool { return x == y }) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+}
+
+func TestUniqueLast(t *testing.T) {
+	payload := []int{1, 2, 1, 3}
+
+	firstWins, expectedFirst := Unique(payload), []int{1, 2, 3}
+	if !Equals(expectedFirst, firstWins, testArrEq) {
+		t.Errorf("unexpected first-wins value, want %v, have %v", expectedFirst, firstWins)
+	}
+
+	lastWins, expectedLast := UniqueLast(payload), []int{2, 1, 3}
+	if !Equals(expectedLast, lastWins, testArrEq) {
+		t.Errorf("unexpected last-wins value, want %v, have %v", expectedLast, lastWins)
+	}
+
+	if actual := UniqueLast[int](nil); actual == nil || len(actual) != 0 {
+		t.Errorf("unexpected value, want empty slice, have %v", actual)
+	}
+
+	type record struct {
+		id      int
+		version int
+	}
+
+	records := []record{{1, 1}, {2, 1}, {1, 2}, {3, 1}, {2, 2}}
+	expected := []record{{1, 2}, {3, 1}, {2, 2}}
+	actual := UniqueByLast(records, func(r record) int { return r.id })
+
+	if !Equals(expected, actual, func(x, y record) bool { return x == y }) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+}
+
+func TestClamp(t *testing.T) {
+	type testCase struct {
+		name     string
+		v        int
+		lo       int
+		hi       int
+		expected int
+	}
+
+	tests := []testCase{
+		{name: "below bounds", v: -5, lo: 0, hi: 10, expected: 0},
+		{name: "at lower bound", v: 0, lo: 0, hi: 10, expected: 0},
+		{name: "within bounds", v: 5, lo: 0, hi: 10, expected: 5},
+		{name: "at upper bound", v: 10, lo: 0, hi: 10, expected: 10},
+		{name: "above bounds", v: 15, lo: 0, hi: 10, expected: 10},
+		{name: "inverted bounds yield lo", v: 5, lo: 10, hi: 0, expected: 10},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := Clamp(test.v, test.lo, test.hi); test.expected != actual {
+				t.Errorf("unexpected value, want %d, have %d", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestClampEach(t *testing.T) {
+	payload := []float64{-1.5, 0, 0.5, 1, 2}
+
+	actual := ClampEach(payload, 0, 1)
+	expected := []float64{0, 0, 0.5, 1, 1}
+
+	if !Equals(expected, actual, func(x, y float64) bool { return x == y }) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+
+	if payload[0] != -1.5 {
+		t.Errorf("unexpected mutation of input, have %v", payload)
+	}
+}
@@ -0,0 +1,65 @@
+package slices
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// ForEachParallel calls `fn` on every element of the slice from a pool of `workers` goroutines,
+// returning the first error. Once an error occurs, pending elements are cancelled and no
+// further calls start, although calls already in flight run to completion. A `workers` lower
+// than 1 defaults to runtime.NumCPU. Elements are not processed in any particular order.
+func ForEachParallel[T any](arr []T, workers int, fn func(T) error) error {
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+
+	if workers > len(arr) {
+		workers = len(arr)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		wg   sync.WaitGroup
+		once sync.Once
+		err  error
+	)
+
+	jobs := make(chan T)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for x := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+
+				if e := fn(x); e != nil {
+					once.Do(func() {
+						err = e
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+feed:
+	for _, x := range arr {
+		select {
+		case jobs <- x:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	return err
+}
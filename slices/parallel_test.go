@@ -0,0 +1,57 @@
+package slices
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+)
+
+func TestForEachParallel(t *testing.T) {
+	payload := make([]int, 1000)
+	for i := range payload {
+		payload[i] = i + 1
+	}
+
+	var sum atomic.Int64
+
+	err := ForEachParallel(payload, 4, func(x int) error {
+		sum.Add(int64(x))
+		return nil
+	})
+
+	if err != nil {
+		t.Errorf("unexpected err, want nil, have %v", err)
+	}
+
+	if sum.Load() != 500500 {
+		t.Errorf("unexpected value, want %d, have %d", 500500, sum.Load())
+	}
+
+	fail := func(int) error { return errors.New("called") }
+
+	if err := ForEachParallel[int](nil, 0, fail); err != nil {
+		t.Errorf("unexpected err, want nil, have %v", err)
+	}
+}
+
+func TestForEachParallel_Cancellation(t *testing.T) {
+	payload := make([]int, 10000)
+	fail := errors.New("boom")
+
+	var calls atomic.Int64
+
+	err := ForEachParallel(payload, 2, func(int) error {
+		if calls.Add(1) == 10 {
+			return fail
+		}
+		return nil
+	})
+
+	if !errors.Is(err, fail) {
+		t.Errorf("unexpected err, want %v, have %v", fail, err)
+	}
+
+	if calls.Load() >= int64(len(payload)) {
+		t.Errorf("unexpected calls, want remaining work to be cancelled, have %d", calls.Load())
+	}
+}
@@ -3,9 +3,20 @@ package slices
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"iter"
+	"sort"
+	"strings"
 
+	"github.com/sonirico/stadio/constraints"
 	"github.com/sonirico/stadio/fp"
+	"github.com/sonirico/stadio/tuples"
+)
+
+var (
+	// ErrShape is returned when a slice cannot be arranged in the requested shape.
+	ErrShape = errors.New("slice does not fit shape")
 )
 
 type (
@@ -23,6 +34,12 @@ func (s Slice[T]) String() string {
 	return buf.String()
 }
 
+// Each calls `fn` on every element and returns the receiver unchanged, so that side effects
+// such as logging can be interleaved in a chain of calls.
+func (s Slice[T]) Each(fn func(t T, i int)) Slice[T] {
+	return Each(s, fn)
+}
+
 func (s Slice[T]) Len() int {
 	return len(s)
 }
@@ -44,6 +61,22 @@ func (s Slice[T]) Get(i int) (res T, ok bool) {
 	return
 }
 
+func (s Slice[T]) First() fp.Option[T] {
+	return First(s)
+}
+
+func (s Slice[T]) Last() fp.Option[T] {
+	return Last(s)
+}
+
+func (s Slice[T]) Tail() Slice[T] {
+	return Tail(s)
+}
+
+func (s Slice[T]) Init() Slice[T] {
+	return Init(s)
+}
+
 func (s Slice[T]) Contains(fn func(t T) bool) bool {
 	return Contains(s, fn)
 }
@@ -53,9 +86,7 @@ func (s Slice[T]) Equals(other Slice[T], predicate func(x, y T) bool) (res bool)
 }
 
 func (s Slice[T]) Clone() Slice[T] {
-	res := make([]T, len(s))
-	copy(res, s)
-	return res
+	return Clone(s)
 }
 
 func (s *Slice[T]) Delete(idx int) Slice[T] {
@@ -105,14 +136,30 @@ func (s Slice[T]) FilterInPlaceCopy(predicate func(x T) bool) Slice[T] {
 	return FilterInPlaceCopy(s, predicate)
 }
 
+// Reduce compacts the slice into a single value of the same type, starting from the zero
+// value. As methods cannot declare type parameters, reducing into a different type requires
+// the package level ReduceTo.
 func (s Slice[T]) Reduce(predicate func(x, y T) T) T {
 	return ReduceSame(s, predicate)
 }
 
+// Fold compacts the slice into a single value of the same type, starting from `initial`. As
+// methods cannot declare type parameters, folding into a different type requires the package
+// level Fold.
 func (s Slice[T]) Fold(predicate func(x, y T) T, initial T) T {
 	return FoldSame(s, predicate, initial)
 }
 
+// Each calls `fn` on every element of the slice and returns it unchanged. Unlike Range,
+// iteration cannot be stopped early.
+func Each[T any](arr []T, fn func(t T, i int)) []T {
+	for i, x := range arr {
+		fn(x, i)
+	}
+
+	return arr
+}
+
 func Equals[T any](one, other []T, predicate func(x, y T) bool) (res bool) {
 	if len(one) != len(other) {
 		return
@@ -129,10 +176,41 @@ func Equals[T any](one, other []T, predicate func(x, y T) bool) (res bool) {
 	return
 }
 
+// EqualUnordered returns whether both slices hold the same elements with the same
+// multiplicities, regardless of their order. Nil and empty slices are equal.
+func EqualUnordered[T comparable](one, other []T) bool {
+	if len(one) != len(other) {
+		return false
+	}
+
+	counts := make(map[T]int, len(one))
+
+	for _, x := range one {
+		counts[x]++
+	}
+
+	for _, x := range other {
+		counts[x]--
+		if counts[x] < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (s Slice[T]) IndexOf(fn func(t T) bool) int {
 	return IndexOf(s, fn)
 }
 
+func (s Slice[T]) IndexOfAll(fn func(t T) bool) []int {
+	return IndexOfAll(s, fn)
+}
+
+func (s Slice[T]) Index(fn func(t T) bool) fp.Option[int] {
+	return Index(s, fn)
+}
+
 func ToMap[V any, K comparable](arr []V, predicate func(x V) K) map[K]V {
 	res := make(map[K]V, len(arr))
 
@@ -171,6 +249,30 @@ func IndexOf[T any](arr []T, predicate func(t T) bool) (pos int) {
 	return
 }
 
+// IndexOfAll returns the positions of every element that matches predicate, in order, or an
+// empty slice if none does.
+func IndexOfAll[T any](arr []T, predicate func(t T) bool) []int {
+	res := make([]int, 0)
+
+	for i, x := range arr {
+		if predicate(x) {
+			res = append(res, i)
+		}
+	}
+
+	return res
+}
+
+// Index returns the position of the first element that matches predicate, wrapped in fp.Some,
+// or fp.None if no element matches.
+func Index[T any](arr []T, predicate func(t T) bool) fp.Option[int] {
+	if idx := IndexOf(arr, predicate); idx >= 0 {
+		return fp.Some(idx)
+	}
+
+	return fp.None[int]()
+}
+
 func Contains[T any](arr []T, predicate func(t T) bool) bool {
 	return IndexOf(arr, predicate) >= 0
 }
@@ -202,6 +304,19 @@ func Map[T, U any](arr []T, predicate func(t T) U) []U {
 	return res
 }
 
+// MapInto transforms every element of `src`, appending the results to `dst` after resetting it
+// to length 0. Its backing array is reused and only grown when its capacity falls short, which
+// avoids allocating when mapping repeatedly with a reusable buffer.
+func MapInto[T, U any](dst []U, src []T, predicate func(t T) U) []U {
+	dst = dst[:0]
+
+	for _, x := range src {
+		dst = append(dst, predicate(x))
+	}
+
+	return dst
+}
+
 func MapInPlace[T any](arr []T, predicate func(t T) T) []T {
 	for i, x := range arr {
 		arr[i] = predicate(x)
@@ -222,6 +337,31 @@ func Filter[T any](arr []T, predicate func(t T) bool) []T {
 	return res
 }
 
+// FilterIndexed discards those elements that do not match predicate, which also receives the
+// position of each element.
+func FilterIndexed[T any](arr []T, predicate func(t T, i int) bool) []T {
+	res := make([]T, 0, len(arr))
+
+	for i, x := range arr {
+		if predicate(x, i) {
+			res = append(res, x)
+		}
+	}
+
+	return res
+}
+
+// MapIndexed transforms every element of the slice, passing its position along to `predicate`.
+func MapIndexed[T, U any](arr []T, predicate func(t T, i int) U) []U {
+	res := make([]U, 0, len(arr))
+
+	for i, x := range arr {
+		res = append(res, predicate(x, i))
+	}
+
+	return res
+}
+
 func FilterMapTuple[T, U any](arr []T, predicate func(t T) (U, bool)) []U {
 	res := make([]U, 0, len(arr))
 
@@ -282,6 +422,13 @@ func Reduce[T, U any](arr []T, p func(T, T) T) (res T) {
 	return Fold(arr, p, res)
 }
 
+// ReduceTo compacts the slice into a single value of a possibly different type, starting from
+// the zero value of that type. Each element is folded exactly once.
+func ReduceTo[T, U any](arr []T, p func(U, T) U) U {
+	var initial U
+	return Fold(arr, p, initial)
+}
+
 func ReduceSame[T any](arr []T, p func(T, T) T) T {
 	return Reduce[T, T](arr, p)
 }
@@ -312,11 +459,58 @@ func Fold[T, U any](arr []T, p func(U, T) U, initial U) U {
 	return initial
 }
 
+// FoldWhile compacts the slice into a single value starting from `initial`, like Fold, but
+// stops as soon as `p` returns false alongside the new accumulator. Remaining elements are not
+// visited.
+func FoldWhile[T, U any](arr []T, p func(U, T) (U, bool), initial U) U {
+	for _, x := range arr {
+		var next bool
+
+		initial, next = p(initial, x)
+		if !next {
+			break
+		}
+	}
+
+	return initial
+}
+
+// Scan is like Fold, but returns every intermediate accumulator rather than just the last one.
+// The initial value is not included, so the result is as long as the input. E.g:
+// Scan([1, 2, 3], add, 0) -> [1, 3, 6]
+func Scan[T, U any](arr []T, p func(U, T) U, initial U) []U {
+	return ScanIndexed(arr, func(acc U, x T, _ int) U { return p(acc, x) }, initial)
+}
+
+// ScanIndexed is like Scan, passing the position of each element along to `p`.
+func ScanIndexed[T, U any](arr []T, p func(U, T, int) U, initial U) []U {
+	res := make([]U, len(arr))
+
+	for i, x := range arr {
+		initial = p(initial, x, i)
+		res[i] = initial
+	}
+
+	return res
+}
+
+// Accumulate returns the running sums of the slice. E.g: Accumulate([1, 2, 3]) -> [1, 3, 6]
+func Accumulate[T constraints.Numeric](arr []T) []T {
+	var zero T
+	return Scan(arr, func(acc, x T) T { return acc + x }, zero)
+}
+
 // Cut removes a sector from slice given lower and upper bounds. Bounds are
 // represented as indices of the slice. E.g:
 // Cut([1, 2, 3, 4], 1, 2) -> [1, 4]
 // Cut([4], 0, 0) -> []
 // Cut will returned the original slice without the cut subslice.
+// Bounds are handled as follows:
+// - a negative `from` or `to` is moved to zero.
+// - a `from` past the end of the slice is a noop: Cut([1, 2, 3], 5, 0) -> [1, 2, 3]
+// - a `to` past the end of the slice is moved to the end.
+// - a `from` greater than `to` considers `to` to be the amount of elements to remove after
+// `from`, clamped to the end of the slice: Cut([1, 2, 3], 2, 1) -> [1, 2]
 func Cut[T any](arr []T, from, to int) []T {
 	if len(arr) < 1 {
 		return arr
@@ -327,7 +521,7 @@ func Cut[T any](arr []T, from, to int) []T {
 	}
 
 	if from >= len(arr) {
-		from = len(arr) - 1
+		return arr
 	}
 
 	if to < 0 {
@@ -338,13 +532,12 @@ func Cut[T any](arr []T, from, to int) []T {
 		to = len(arr) - 1
 	}
 
-	if len(arr) == 1 {
-		return arr[:0]
-	}
-
 	if from > to {
 		// In this case, consider `to` to be the amount to remove from `from`.
-		return append(arr[:from], arr[from+to+1:]...)
+		to = from + to
+		if to >= len(arr) {
+			to = len(arr) - 1
+		}
 	}
 
 	return append(arr[:from], arr[to+1:]...)
@@ -358,6 +551,32 @@ func AppendVector[T any](arr, items []T) []T {
 	return append(arr, items...)
 }
 
+// Prepend returns a fresh slice holding `items` followed by the elements of `arr`. The input's
+// backing array is never written to.
+func Prepend[T any](arr []T, items ...T) []T {
+	return Concat(items, arr)
+}
+
+// With returns a fresh slice holding the elements of `arr` followed by `items`. Unlike
+// AppendVector, the input's backing array is never written to, so spare capacity shared with
+// other slices cannot be overwritten.
+func With[T any](arr []T, items ...T) []T {
+	return Concat(arr, items)
+}
+
+// AppendUnique appends each of `items` not yet present in `arr`, preserving their order. Like
+// AppendVector, it may write into the input's spare capacity. Every item is looked up linearly,
+// so the cost is O(n*m); for heavy use prefer the set.Set type.
+func AppendUnique[T comparable](arr []T, items ...T) []T {
+	for _, item := range items {
+		if !Includes(arr, item) {
+			arr = append(arr, item)
+		}
+	}
+
+	return arr
+}
+
 // Delete removes the element in `idx` position, without preserving array order. In case `idx`
 // is out of bounds, noop.
 func Delete[T any](arr []T, idx int) []T {
@@ -522,3 +741,784 @@ func InsertVector[T any](arr, items []T, idx int) (res []T) {
 
 	return append(arr[:idx], append(items, arr[idx:]...)...)
 }
+
+// Join formats every element of the slice with `fn` and concatenates the results, placing
+// `sep` between them. E.g:
+// Join([1, 2, 3], ", ", itoa) -> "1, 2, 3"
+func Join[T any](arr []T, sep string, fn func(T) string) string {
+	if len(arr) < 1 {
+		return ""
+	}
+
+	var buf strings.Builder
+
+	buf.WriteString(fn(arr[0]))
+
+	for _, x := range arr[1:] {
+		buf.WriteString(sep)
+		buf.WriteString(fn(x))
+	}
+
+	return buf.String()
+}
+
+// Concat joins all the given slices into a newly allocated one, sized after the total length of
+// the inputs. Input slices are never mutated and nil ones are skipped.
+func Concat[T any](arrs ...[]T) []T {
+	size := 0
+	for _, arr := range arrs {
+		size += len(arr)
+	}
+
+	res := make([]T, 0, size)
+
+	for _, arr := range arrs {
+		if arr == nil {
+			continue
+		}
+
+		res = append(res, arr...)
+	}
+
+	return res
+}
+
+// First returns the head of the slice wrapped in fp.Some, or fp.None if the slice is empty.
+func First[T any](arr []T) fp.Option[T] {
+	if len(arr) < 1 {
+		return fp.None[T]()
+	}
+
+	return fp.Some(arr[0])
+}
+
+// Last returns the tail of the slice wrapped in fp.Some, or fp.None if the slice is empty.
+func Last[T any](arr []T) fp.Option[T] {
+	if len(arr) < 1 {
+		return fp.None[T]()
+	}
+
+	return fp.Some(arr[len(arr)-1])
+}
+
+// Tail returns all the elements but the first one, or an empty slice if there are less than two.
+// The result is a subslice of the input, so no allocation takes place.
+func Tail[T any](arr []T) []T {
+	if len(arr) < 1 {
+		return arr
+	}
+
+	return arr[1:]
+}
+
+// Init returns all the elements but the last one, or an empty slice if there are less than two.
+// The result is a subslice of the input, so no allocation takes place. Beware that appending to
+// it overwrites the last element of the input.
+func Init[T any](arr []T) []T {
+	if len(arr) < 1 {
+		return arr
+	}
+
+	return arr[:len(arr)-1]
+}
+
+// Cycle returns a new slice of length `n` made by repeating the elements of `arr` in order. E.g:
+// Cycle([a, b], 5) -> [a, b, a, b, a]. An empty input or a non-positive `n` yields an empty
+// slice.
+func Cycle[T any](arr []T, n int) []T {
+	if len(arr) < 1 || n < 1 {
+		return []T{}
+	}
+
+	res := make([]T, n)
+
+	for i := 0; i < n; i += len(arr) {
+		copy(res[i:], arr)
+	}
+
+	return res
+}
+
+// ChunkBy splits the slice into runs of consecutive elements sharing the same key, starting a
+// new chunk whenever the key changes. Chunks are subslices of the input. E.g:
+// ChunkBy([1, 1, 2, 3, 3], id) -> [[1, 1], [2], [3, 3]]
+func ChunkBy[T any, K comparable](arr []T, keyFn func(T) K) [][]T {
+	if len(arr) < 1 {
+		return nil
+	}
+
+	res := make([][]T, 0)
+	start := 0
+	key := keyFn(arr[0])
+
+	for i := 1; i < len(arr); i++ {
+		next := keyFn(arr[i])
+		if next != key {
+			res = append(res, arr[start:i:i])
+			start = i
+			key = next
+		}
+	}
+
+	return append(res, arr[start:len(arr):len(arr)])
+}
+
+// IndexOfSubslice returns the position at which the first occurrence of `needle` starts within
+// `haystack`, or -1 if it is not present. An empty needle is found at position 0.
+func IndexOfSubslice[T comparable](haystack, needle []T) int {
+	if len(needle) < 1 {
+		return 0
+	}
+
+	for i := 0; i+len(needle) <= len(haystack); i++ {
+		found := true
+
+		for j, x := range needle {
+			if haystack[i+j] != x {
+				found = false
+				break
+			}
+		}
+
+		if found {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// ContainsSubslice returns whether `needle` appears as a contiguous subsequence of `haystack`.
+func ContainsSubslice[T comparable](haystack, needle []T) bool {
+	return IndexOfSubslice(haystack, needle) >= 0
+}
+
+// MaxIndex returns the position of the greatest element, or -1 if the slice is empty. On ties,
+// the first position wins.
+func MaxIndex[T constraints.Ordered](arr []T) int {
+	return MaxIndexBy(arr, func(x, y T) bool { return x < y })
+}
+
+// MinIndex returns the position of the lowest element, or -1 if the slice is empty. On ties,
+// the first position wins.
+func MinIndex[T constraints.Ordered](arr []T) int {
+	return MinIndexBy(arr, func(x, y T) bool { return x < y })
+}
+
+// MaxIndexBy returns the position of the greatest element according to `less`, or -1 if the
+// slice is empty. On ties, the first position wins.
+func MaxIndexBy[T any](arr []T, less func(x, y T) bool) int {
+	return MinIndexBy(arr, func(x, y T) bool { return less(y, x) })
+}
+
+// MinIndexBy returns the position of the lowest element according to `less`, or -1 if the
+// slice is empty. On ties, the first position wins.
+func MinIndexBy[T any](arr []T, less func(x, y T) bool) int {
+	if len(arr) < 1 {
+		return -1
+	}
+
+	idx := 0
+
+	for i := 1; i < len(arr); i++ {
+		if less(arr[i], arr[idx]) {
+			idx = i
+		}
+	}
+
+	return idx
+}
+
+// FromSeq collects all the values yielded by the sequence into a new slice.
+func FromSeq[T any](seq iter.Seq[T]) []T {
+	return CollectCap(seq, 0)
+}
+
+// CollectCap collects all the values yielded by the sequence into a new slice, preallocated
+// with the given capacity. It is the terminal operation of lazy pipelines built with ToSeq,
+// FilterLazy, MapLazy and FilterMapLazy.
+func CollectCap[T any](seq iter.Seq[T], capacity int) []T {
+	res := make([]T, 0, capacity)
+
+	for x := range seq {
+		res = append(res, x)
+	}
+
+	return res
+}
+
+// ToSeq returns a sequence yielding the elements of the slice in order, to be used as the
+// source of lazy pipelines.
+func ToSeq[T any](arr []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, x := range arr {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// ToChannel returns a closed channel holding the elements of the slice in order. The channel is
+// buffered to the length of the slice and filled before returning, so no goroutine is spawned
+// and nothing leaks if the receiver stops draining it early.
+func ToChannel[T any](arr []T) <-chan T {
+	ch := make(chan T, len(arr))
+
+	for _, x := range arr {
+		ch <- x
+	}
+
+	close(ch)
+
+	return ch
+}
+
+// FromChannel drains the channel into a new slice, blocking until the channel is closed.
+func FromChannel[T any](ch <-chan T) []T {
+	res := make([]T, 0, len(ch))
+
+	for x := range ch {
+		res = append(res, x)
+	}
+
+	return res
+}
+
+// FilterLazy returns a sequence yielding the values of `seq` that match predicate. Lazy
+// operations do nothing until the resulting sequence is consumed, e.g. by CollectCap, and
+// evaluate `predicate` once per value each time it is consumed.
+func FilterLazy[T any](seq iter.Seq[T], predicate func(t T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for x := range seq {
+			if predicate(x) && !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// MapLazy returns a sequence yielding the values of `seq` transformed by `predicate`. Lazy
+// operations do nothing until the resulting sequence is consumed, e.g. by CollectCap, and
+// evaluate `predicate` once per value each time it is consumed.
+func MapLazy[T, U any](seq iter.Seq[T], predicate func(t T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for x := range seq {
+			if !yield(predicate(x)) {
+				return
+			}
+		}
+	}
+}
+
+// FilterMapLazy is the lazy counterpart of FilterMap: it returns a sequence yielding the
+// values wrapped by fp.Some, discarding those mapped to fp.None, without allocating an
+// intermediate slice. Lazy operations do nothing until the resulting sequence is consumed.
+func FilterMapLazy[T, U any](seq iter.Seq[T], predicate func(t T) fp.Option[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for x := range seq {
+			if v, ok := predicate(x).Unwrap(); ok && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Clone returns a shallow copy of the slice. Nil slices yield nil.
+func Clone[T any](arr []T) []T {
+	if arr == nil {
+		return nil
+	}
+
+	res := make([]T, len(arr))
+	copy(res, arr)
+	return res
+}
+
+// CloneFunc returns a deep copy of the slice, using `clone` to copy every element. Use it
+// instead of Slice.Clone for elements holding references, such as pointers or nested slices.
+func CloneFunc[T any](arr []T, clone func(T) T) []T {
+	if arr == nil {
+		return nil
+	}
+
+	res := make([]T, len(arr))
+
+	for i, x := range arr {
+		res[i] = clone(x)
+	}
+
+	return res
+}
+
+// CollectMap consumes the whole sequence into a new map. Later pairs overwrite earlier ones
+// sharing the same key. A nil sequence yields an empty map. As the sequence is never stopped
+// early, infinite sequences make CollectMap never return.
+func CollectMap[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+	res := make(map[K]V)
+
+	if seq == nil {
+		return res
+	}
+
+	for k, v := range seq {
+		res[k] = v
+	}
+
+	return res
+}
+
+// GroupBy groups the elements of the slice by the key derived from `keyFn`, preserving their
+// order within each group. Nil slices yield an empty map.
+func GroupBy[T any, K comparable](arr []T, keyFn func(T) K) map[K][]T {
+	res := make(map[K][]T)
+
+	for _, x := range arr {
+		k := keyFn(x)
+		res[k] = append(res[k], x)
+	}
+
+	return res
+}
+
+// GroupReduce groups the elements of the slice by the key derived from `keyFn` and folds each
+// group, in order, starting from `initial`. It is equivalent to GroupBy followed by a Fold of
+// every group, in a single pass and without materializing the groups. Nil slices yield an empty
+// map.
+func GroupReduce[T any, K comparable, R any](
+	arr []T,
+	keyFn func(T) K,
+	p func(R, T) R,
+	initial R,
+) map[K]R {
+	res := make(map[K]R)
+
+	for _, x := range arr {
+		k := keyFn(x)
+
+		acc, ok := res[k]
+		if !ok {
+			acc = initial
+		}
+
+		res[k] = p(acc, x)
+	}
+
+	return res
+}
+
+// GroupBySeq consumes the whole sequence into a new map, grouping values by the key derived
+// from `keyFn` and preserving their order within each group. A nil sequence yields an empty
+// map. As the sequence is never stopped early, infinite sequences make GroupBySeq never return.
+func GroupBySeq[T any, K comparable](seq iter.Seq[T], keyFn func(T) K) map[K][]T {
+	res := make(map[K][]T)
+
+	if seq == nil {
+		return res
+	}
+
+	for x := range seq {
+		k := keyFn(x)
+		res[k] = append(res[k], x)
+	}
+
+	return res
+}
+
+// Frequency counts the occurrences of each distinct element of the slice. Nil slices yield an
+// empty map.
+func Frequency[T comparable](arr []T) map[T]int {
+	res := make(map[T]int)
+
+	for _, x := range arr {
+		res[x]++
+	}
+
+	return res
+}
+
+// TopK returns the `k` most frequent elements of the slice paired with their counts, sorted by
+// count in descending order. Ties are broken by first appearance. If `k` exceeds the number of
+// distinct elements, all of them are returned. E.g:
+// TopK([a, b, b, c, c], 2) -> [(b, 2), (c, 2)]
+func TopK[T comparable](arr []T, k int) []tuples.Tuple2[T, int] {
+	idx := make(map[T]int)
+	res := make([]tuples.Tuple2[T, int], 0)
+
+	for _, x := range arr {
+		i, ok := idx[x]
+		if !ok {
+			i = len(res)
+			idx[x] = i
+			res = append(res, tuples.Tuple2[T, int]{V1: x})
+		}
+		res[i].V2++
+	}
+
+	sort.SliceStable(res, func(i, j int) bool { return res[i].V2 > res[j].V2 })
+
+	if k < 0 {
+		k = 0
+	}
+
+	if k < len(res) {
+		res = res[:k]
+	}
+
+	return res
+}
+
+// SplitAt returns the prefix and the suffix of the slice split at `idx`, which is clamped to the
+// bounds of the slice. Both parts share memory with the input. E.g:
+// SplitAt([1, 2, 3], 1) -> [1], [2, 3]
+func SplitAt[T any](arr []T, idx int) ([]T, []T) {
+	if idx < 0 {
+		idx = 0
+	}
+
+	if idx > len(arr) {
+		idx = len(arr)
+	}
+
+	return arr[:idx:idx], arr[idx:]
+}
+
+// SplitWhen splits the slice into groups, starting a new one at every element that matches
+// predicate. Matching elements are kept at the start of the group they open, and a match at
+// the head of the slice does not produce an empty group. Groups share memory with the input.
+// E.g:
+// SplitWhen([1, 0, 2, 0, 3], isZero) -> [[1], [0, 2], [0, 3]]
+func SplitWhen[T any](arr []T, predicate func(t T) bool) [][]T {
+	if len(arr) < 1 {
+		return nil
+	}
+
+	res := make([][]T, 0)
+	start := 0
+
+	for i := 1; i < len(arr); i++ {
+		if predicate(arr[i]) {
+			res = append(res, arr[start:i:i])
+			start = i
+		}
+	}
+
+	return append(res, arr[start:len(arr):len(arr)])
+}
+
+// Batch calls `fn` on consecutive windows of `size` elements, one at a time, stopping at and
+// returning the first error. The last batch may be smaller. A `size` lower than 1 processes the
+// whole slice as a single batch. Batches share memory with the input.
+func Batch[T any](arr []T, size int, fn func(batch []T) error) error {
+	if size < 1 {
+		size = len(arr)
+	}
+
+	for start := 0; start < len(arr); start += size {
+		end := start + size
+		if end > len(arr) {
+			end = len(arr)
+		}
+
+		if err := fn(arr[start:end:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Permutations returns every ordering of the elements of the slice, in lexicographic order of
+// their positions. Each permutation is a newly allocated slice. Beware that there are n! of them,
+// so prefer PermutationsSeq to avoid holding them all in memory.
+func Permutations[T any](arr []T) [][]T {
+	return CollectCap(PermutationsSeq(arr), 0)
+}
+
+// PermutationsSeq is the lazy counterpart of Permutations, yielding one newly allocated
+// permutation at a time. An empty slice yields a single empty permutation.
+func PermutationsSeq[T any](arr []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		idx := make([]int, len(arr))
+		for i := range idx {
+			idx[i] = i
+		}
+
+		for {
+			if !yield(pick(arr, idx)) {
+				return
+			}
+
+			// Advance to the next permutation of the positions.
+			i := len(idx) - 2
+			for i >= 0 && idx[i] > idx[i+1] {
+				i--
+			}
+
+			if i < 0 {
+				return
+			}
+
+			j := len(idx) - 1
+			for idx[j] < idx[i] {
+				j--
+			}
+
+			idx[i], idx[j] = idx[j], idx[i]
+			reverse(idx[i+1:])
+		}
+	}
+}
+
+// Combinations returns every subset of `k` elements of the slice, keeping the input order within
+// each subset. Each combination is a newly allocated slice. Beware that there are C(n, k) of
+// them, so prefer CombinationsSeq to avoid holding them all in memory.
+func Combinations[T any](arr []T, k int) [][]T {
+	return CollectCap(CombinationsSeq(arr, k), 0)
+}
+
+// CombinationsSeq is the lazy counterpart of Combinations, yielding one newly allocated
+// combination at a time. A `k` of 0 yields a single empty combination, whereas a negative `k` or
+// one greater than the length of the slice yields none.
+func CombinationsSeq[T any](arr []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if k < 0 || k > len(arr) {
+			return
+		}
+
+		idx := make([]int, k)
+		for i := range idx {
+			idx[i] = i
+		}
+
+		for {
+			if !yield(pick(arr, idx)) {
+				return
+			}
+
+			// Advance the rightmost position that has not reached its last possible value.
+			i := k - 1
+			for i >= 0 && idx[i] == len(arr)-k+i {
+				i--
+			}
+
+			if i < 0 {
+				return
+			}
+
+			idx[i]++
+			for j := i + 1; j < k; j++ {
+				idx[j] = idx[j-1] + 1
+			}
+		}
+	}
+}
+
+func pick[T any](arr []T, idx []int) []T {
+	res := make([]T, len(idx))
+	for i, j := range idx {
+		res[i] = arr[j]
+	}
+	return res
+}
+
+// Chunk splits the slice into consecutive chunks of `size` elements, the last of which may be
+// smaller. A `size` lower than 1 yields the whole slice as a single chunk. Chunks share memory
+// with the input. E.g: Chunk([1, 2, 3, 4, 5], 2) -> [[1, 2], [3, 4], [5]]
+func Chunk[T any](arr []T, size int) [][]T {
+	res := make([][]T, 0)
+
+	_ = Batch(arr, size, func(batch []T) error {
+		res = append(res, batch)
+		return nil
+	})
+
+	return res
+}
+
+// ChunkPadded is like Chunk, but fills the last chunk with `pad` up to `size` so that every chunk
+// has the same length. Unlike the rest, the padded chunk is a copy not sharing memory with the
+// input. E.g: ChunkPadded([1, 2, 3, 4, 5], 2, 0) -> [[1, 2], [3, 4], [5, 0]]
+func ChunkPadded[T any](arr []T, size int, pad T) [][]T {
+	res := Chunk(arr, size)
+
+	if len(res) < 1 || size < 1 {
+		return res
+	}
+
+	last := res[len(res)-1]
+	if len(last) == size {
+		return res
+	}
+
+	padded := make([]T, size)
+	n := copy(padded, last)
+	for i := n; i < size; i++ {
+		padded[i] = pad
+	}
+
+	res[len(res)-1] = padded
+
+	return res
+}
+
+// Reshape arranges a flat slice as a grid of rows `cols` elements wide, the last of which may be
+// shorter. It behaves exactly like Chunk, rows sharing memory with the input. See ReshapeExact
+// to require a complete grid. E.g: Reshape([1, 2, 3, 4, 5], 2) -> [[1, 2], [3, 4], [5]]
+func Reshape[T any](arr []T, cols int) [][]T {
+	return Chunk(arr, cols)
+}
+
+// ReshapeExact is like Reshape, but fails with ErrShape unless `cols` is positive and the length
+// of the slice is a multiple of it, so that every row has the same width.
+func ReshapeExact[T any](arr []T, cols int) ([][]T, error) {
+	if cols < 1 || len(arr)%cols != 0 {
+		return nil, fmt.Errorf("%w: %d elements into %d columns", ErrShape, len(arr), cols)
+	}
+
+	return Chunk(arr, cols), nil
+}
+
+// CopyInto copies `src` into `dst` starting at position `at`, truncating whatever does not fit
+// in `dst`, and returns the amount of elements copied. A negative or out of bounds `at` is a
+// noop returning 0.
+func CopyInto[T any](dst, src []T, at int) int {
+	if at < 0 || at >= len(dst) {
+		return 0
+	}
+
+	return copy(dst[at:], src)
+}
+
+// RemoveFirst removes the first element equal to `target`, preserving order. The backing array
+// of the input is reused. No-op if `target` is absent.
+func RemoveFirst[T comparable](arr []T, target T) []T {
+	idx := IndexOf(arr, func(x T) bool { return x == target })
+	return DeleteOrder(arr, idx)
+}
+
+// RemoveAll removes every element equal to `target`, preserving order. The backing array of
+// the input is reused. No-op if `target` is absent.
+func RemoveAll[T comparable](arr []T, target T) []T {
+	return FilterInPlace(arr, func(x T) bool { return x != target })
+}
+
+// Apply threads the slice through each of the given functions, in order, returning the output
+// of the last one. E.g:
+// Apply(arr, evens, double) is equivalent to double(evens(arr))
+func Apply[T any](arr []T, fns ...func([]T) []T) []T {
+	for _, fn := range fns {
+		arr = fn(arr)
+	}
+
+	return arr
+}
+
+// WindowReduce applies `fn` to every sliding window of `size` consecutive elements, returning
+// the results in order. Each window is a copy, so `fn` may keep or mutate it safely. A `size`
+// lower than 1 or greater than the length of the slice yields an empty result. E.g:
+// WindowReduce([1, 2, 3, 4], 2, sum) -> [3, 5, 7]
+func WindowReduce[T, U any](arr []T, size int, fn func(window []T) U) []U {
+	if size < 1 || size > len(arr) {
+		return []U{}
+	}
+
+	res := make([]U, 0, len(arr)-size+1)
+
+	for i := 0; i+size <= len(arr); i++ {
+		window := make([]T, size)
+		copy(window, arr[i:i+size])
+		res = append(res, fn(window))
+	}
+
+	return res
+}
+
+// Flatten concatenates the nested slices into a single one, one level deep.
+func Flatten[T any](arr [][]T) []T {
+	return Concat(arr...)
+}
+
+// FlattenDeep recursively flattens nested []any values into a single flat slice, keeping any
+// other value as a leaf. Nesting is detected through a type switch, so only []any is unfolded:
+// typed slices such as []int are kept as leaves. E.g:
+// FlattenDeep([1, [2, [3, []]], "4"]) -> [1, 2, 3, "4"]
+func FlattenDeep(arr []any) []any {
+	res := make([]any, 0, len(arr))
+	return flattenDeep(res, arr)
+}
+
+func flattenDeep(res, arr []any) []any {
+	for _, x := range arr {
+		switch nested := x.(type) {
+		case []any:
+			res = flattenDeep(res, nested)
+		default:
+			res = append(res, x)
+		}
+	}
+
+	return res
+}
+
+// Partition splits the slice into the elements that match predicate and those that do not,
+// preserving their relative order in both outputs.
+func Partition[T any](arr []T, predicate func(t T) bool) (matched, unmatched []T) {
+	matched = make([]T, 0, len(arr))
+	unmatched = make([]T, 0)
+
+	for _, x := range arr {
+		if predicate(x) {
+			matched = append(matched, x)
+		} else {
+			unmatched = append(unmatched, x)
+		}
+	}
+
+	return
+}
+
+// StablePartitionInPlace reorders the slice so that elements matching predicate come first,
+// preserving the relative order within both halves, and returns the index of the first
+// unmatched element. It does not allocate, running in O(n log n) swaps. E.g:
+// StablePartitionInPlace([1, 2, 3, 4, 5], isOdd) -> 3, [1, 3, 5, 2, 4]
+func StablePartitionInPlace[T any](arr []T, predicate func(t T) bool) int {
+	switch len(arr) {
+	case 0:
+		return 0
+	case 1:
+		if predicate(arr[0]) {
+			return 1
+		}
+		return 0
+	}
+
+	mid := len(arr) / 2
+	left := StablePartitionInPlace(arr[:mid], predicate)
+	right := StablePartitionInPlace(arr[mid:], predicate)
+
+	// arr is now [left matched | left unmatched | right matched | right unmatched]; swap the
+	// middle blocks to join both matched ones.
+	rotate(arr[left:mid+right], mid-left)
+
+	return left + right
+}
+
+// rotate moves the first `k` elements of the slice to its end, preserving the order.
+func rotate[T any](arr []T, k int) {
+	reverse(arr[:k])
+	reverse(arr[k:])
+	reverse(arr)
+}
+
+func reverse[T any](arr []T) {
+	for i, j := 0, len(arr)-1; i < j; i, j = i+1, j-1 {
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+}
+
+// CompactOptions returns the values wrapped by fp.Some, in order, discarding fp.None entries.
+// Nil slices yield an empty slice.
+func CompactOptions[T any](opts []fp.Option[T]) []T {
+	return FilterMap(opts, func(o fp.Option[T]) fp.Option[T] { return o })
+}
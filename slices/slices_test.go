@@ -1,9 +1,14 @@
 package slices
 
 import (
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
 	"testing"
 
 	"github.com/sonirico/stadio/fp"
+	"github.com/sonirico/stadio/tuples"
 )
 
 func TestSlice_Len(t *testing.T) {
@@ -180,6 +185,144 @@ func TestSlice_Append(t *testing.T) {
 	}
 }
 
+func TestPrependWith(t *testing.T) {
+	backing := make([]int, 3, 10)
+	copy(backing, []int{1, 2, 3})
+	original := []int{1, 2, 3}
+
+	withed := With(backing, 4, 5)
+	if !Equals(withed, []int{1, 2, 3, 4, 5}, testArrEq) {
+		t.Errorf("unexpected value, want %v, have %v", []int{1, 2, 3, 4, 5}, withed)
+	}
+
+	prepended := Prepend(backing, -1, 0)
+	if !Equals(prepended, []int{-1, 0, 1, 2, 3}, testArrEq) {
+		t.Errorf("unexpected value, want %v, have %v", []int{-1, 0, 1, 2, 3}, prepended)
+	}
+
+	withed[0] = 100
+	prepended[2] = 100
+
+	if !Equals(backing, original, testArrEq) {
+		t.Errorf("unexpected mutation, want %v, have %v", original, backing)
+	}
+
+	if spare := backing[:5]; spare[3] != 0 || spare[4] != 0 {
+		t.Errorf("unexpected write into spare capacity, have %v", spare)
+	}
+
+	if fresh := With[int](nil); fresh == nil || len(fresh) != 0 {
+		t.Errorf("unexpected value, want empty non-nil slice, have %v", fresh)
+	}
+}
+
+func TestAppendUnique(t *testing.T) {
+	type testCase struct {
+		name     string
+		payload  []int
+		items    []int
+		expected []int
+	}
+
+	tests := []testCase{
+		{
+			name:     "nil slice takes every distinct item",
+			payload:  nil,
+			items:    []int{1, 2, 1},
+			expected: []int{1, 2},
+		},
+		{
+			name:     "no items is noop",
+			payload:  []int{1, 2},
+			items:    nil,
+			expected: []int{1, 2},
+		},
+		{
+			name:     "mix of new and existing items",
+			payload:  []int{1, 2, 3},
+			items:    []int{3, 4, 1, 5, 4},
+			expected: []int{1, 2, 3, 4, 5},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := AppendUnique(test.payload, test.items...)
+
+			if !Equals(test.expected, actual, testArrEq) {
+				t.Errorf("unexpected value, want %v, have %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSlice_Each(t *testing.T) {
+	payload := Slice[int]([]int{1, 2, 3})
+	visited := make([]int, 0, payload.Len())
+
+	actual := payload.
+		Map(func(x int) int { return x * 2 }).
+		Each(func(x int, i int) { visited = append(visited, i) }).
+		Filter(func(x int) bool { return x > 2 })
+
+	if !Equals(visited, []int{0, 1, 2}, testArrEq) {
+		t.Errorf("unexpected visited indices, want %v, have %v", []int{0, 1, 2}, visited)
+	}
+
+	if !Equals(actual, []int{4, 6}, testArrEq) {
+		t.Errorf("unexpected value, want %v, have %v", []int{4, 6}, actual)
+	}
+
+	same := payload.Each(func(int, int) {})
+	if &same[0] != &payload[0] || same.Len() != payload.Len() {
+		t.Errorf("unexpected slice, want the receiver to be returned")
+	}
+}
+
+func TestEqualUnordered(t *testing.T) {
+	type testCase struct {
+		name     string
+		one      []int
+		other    []int
+		expected bool
+	}
+
+	tests := []testCase{
+		{
+			name:     "nil and empty are equal",
+			one:      nil,
+			other:    []int{},
+			expected: true,
+		},
+		{
+			name:     "same elements in different order",
+			one:      []int{1, 2, 1},
+			other:    []int{2, 1, 1},
+			expected: true,
+		},
+		{
+			name:     "same elements with different multiplicities",
+			one:      []int{1, 1, 2},
+			other:    []int{1, 2, 2},
+			expected: false,
+		},
+		{
+			name:     "different lengths",
+			one:      []int{1, 2},
+			other:    []int{1, 2, 2},
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := EqualUnordered(test.one, test.other); test.expected != actual {
+				t.Errorf("unexpected result, want %t, have %t", test.expected, actual)
+			}
+		})
+	}
+}
+
 func TestSlice_IndexOf(t *testing.T) {
 	type testCase struct {
 		name        string
@@ -234,6 +377,61 @@ func TestSlice_IndexOf(t *testing.T) {
 	}
 }
 
+func TestIndex(t *testing.T) {
+	payload := Slice[int]([]int{1, 2, 3, 2})
+
+	actual := payload.Index(func(x int) bool { return x == 2 })
+	if expected := fp.Some(1); expected != actual {
+		t.Errorf("unexpected index, want %v, have %v", expected, actual)
+	}
+
+	actual = Index(payload, func(x int) bool { return x > 3 })
+	if actual.IsSome() {
+		t.Errorf("unexpected index, want none, have %v", actual)
+	}
+
+	actual = Index[int](nil, func(int) bool { return true })
+	if actual.IsSome() {
+		t.Errorf("unexpected index on nil slice, want none, have %v", actual)
+	}
+}
+
+func TestIndexOfAll(t *testing.T) {
+	type testCase struct {
+		name     string
+		payload  Slice[int]
+		expected []int
+	}
+
+	tests := []testCase{
+		{
+			name:     "no matches",
+			payload:  Slice[int]([]int{1, 3}),
+			expected: []int{},
+		},
+		{
+			name:     "one match",
+			payload:  Slice[int]([]int{1, 2, 3}),
+			expected: []int{1},
+		},
+		{
+			name:     "several matches",
+			payload:  Slice[int]([]int{2, 1, 4, 3, 6}),
+			expected: []int{0, 2, 4},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := test.payload.IndexOfAll(func(x int) bool { return x%2 == 0 })
+
+			if actual == nil || !Equals(test.expected, actual, testArrEq) {
+				t.Errorf("unexpected indices, want %v, have %v", test.expected, actual)
+			}
+		})
+	}
+}
+
 func TestContains(t *testing.T) {
 	type testCase struct {
 		name     string
@@ -437,6 +635,119 @@ func TestReduce(t *testing.T) {
 	}
 }
 
+func TestReduce_SingleElement(t *testing.T) {
+	predicate := func(x, y int) int { return x + y }
+
+	if actual := ReduceSame([]int{5}, predicate); actual != 5 {
+		t.Errorf("unexpected value, want %d, have %d", 5, actual)
+	}
+
+	if actual := Slice[int]([]int{5}).Reduce(predicate); actual != 5 {
+		t.Errorf("unexpected value, want %d, have %d", 5, actual)
+	}
+}
+
+func TestReduceTo(t *testing.T) {
+	actual := ReduceTo([]int{1, 2, 3}, func(acc string, x int) string {
+		return acc + strconv.Itoa(x)
+	})
+
+	if actual != "123" {
+		t.Errorf("unexpected value, want %q, have %q", "123", actual)
+	}
+
+	total := ReduceTo([]int{5}, func(acc int64, x int) int64 { return acc + int64(x) })
+
+	if total != 5 {
+		t.Errorf("unexpected value, want %d, have %d", 5, total)
+	}
+}
+
+func TestFoldWhile(t *testing.T) {
+	visited := 0
+
+	actual := FoldWhile([]int{2, 3, 4, 5, 6}, func(acc, x int) (int, bool) {
+		visited++
+		acc *= x
+		return acc, acc <= 20
+	}, 1)
+
+	if actual != 24 {
+		t.Errorf("unexpected value, want %d, have %d", 24, actual)
+	}
+
+	if visited != 3 {
+		t.Errorf("unexpected visited elements, want %d, have %d", 3, visited)
+	}
+
+	sum := func(acc, x int) (int, bool) { return acc + x, true }
+	total := FoldWhile([]int{1, 2, 3}, sum, 0)
+
+	if total != 6 {
+		t.Errorf("unexpected value, want %d, have %d", 6, total)
+	}
+
+	if empty := FoldWhile(nil, sum, 7); empty != 7 {
+		t.Errorf("unexpected value, want %d, have %d", 7, empty)
+	}
+}
+
+func TestScan(t *testing.T) {
+	concat := func(acc string, x int) string { return acc + strconv.Itoa(x) }
+	actual := Scan([]int{1, 2, 3}, concat, ">")
+	expected := []string{">1", ">12", ">123"}
+
+	if !Equals(expected, actual, func(x, y string) bool { return x == y }) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+
+	weighted := ScanIndexed([]int{5, 5, 5}, func(acc, x, i int) int { return acc + x*i }, 0)
+
+	if !Equals(weighted, []int{0, 5, 15}, testArrEq) {
+		t.Errorf("unexpected value, want %v, have %v", []int{0, 5, 15}, weighted)
+	}
+}
+
+func TestAccumulate(t *testing.T) {
+	type testCase struct {
+		name     string
+		payload  []int
+		expected []int
+	}
+
+	tests := []testCase{
+		{
+			name:     "nil slice yields empty",
+			payload:  nil,
+			expected: []int{},
+		},
+		{
+			name:     "single element",
+			payload:  []int{4},
+			expected: []int{4},
+		},
+		{
+			name:     "running sums",
+			payload:  []int{1, 2, 3, -4},
+			expected: []int{1, 3, 6, 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := Accumulate(test.payload)
+
+			if actual == nil || !Equals(test.expected, actual, testArrEq) {
+				t.Errorf("unexpected value, want %v, have %v", test.expected, actual)
+			}
+		})
+	}
+
+	if actual := Accumulate([]float64{0.5, 0.25}); actual[1] != 0.75 {
+		t.Errorf("unexpected value, want 0.75, have %v", actual[1])
+	}
+}
+
 func TestCut(t *testing.T) {
 	type testCase struct {
 		name     string
@@ -490,11 +801,53 @@ func TestCut(t *testing.T) {
 			expected: Slice[int]([]int{1}),
 		},
 		{
-			name:     "`from` greater than slice length is moved to end",
+			name:     "`from` greater than slice length is noop",
 			payload:  Slice[int]([]int{1, 2}),
 			from:     3,
 			to:       0,
-			expected: Slice[int]([]int{1}),
+			expected: Slice[int]([]int{1, 2}),
+		},
+		{
+			name:     "`from` equal to slice length is noop",
+			payload:  Slice[int]([]int{1, 2}),
+			from:     2,
+			to:       1,
+			expected: Slice[int]([]int{1, 2}),
+		},
+		{
+			name:     "`from` fully past the end with nonzero `to` is noop",
+			payload:  Slice[int]([]int{1, 2, 3}),
+			from:     5,
+			to:       2,
+			expected: Slice[int]([]int{1, 2, 3}),
+		},
+		{
+			name:     "`from` greater than `to` clamps amount to the end",
+			payload:  Slice[int]([]int{1, 2, 3}),
+			from:     2,
+			to:       1,
+			expected: Slice[int]([]int{1, 2}),
+		},
+		{
+			name:     "`from` greater than `to` removes `to` extra items",
+			payload:  Slice[int]([]int{1, 2, 3, 4}),
+			from:     2,
+			to:       1,
+			expected: Slice[int]([]int{1, 2}),
+		},
+		{
+			name:     "`from` greater than `to` with zero amount removes one item",
+			payload:  Slice[int]([]int{1, 2, 3, 4}),
+			from:     2,
+			to:       0,
+			expected: Slice[int]([]int{1, 2, 4}),
+		},
+		{
+			name:     "cut last item",
+			payload:  Slice[int]([]int{1, 2, 3}),
+			from:     2,
+			to:       2,
+			expected: Slice[int]([]int{1, 2}),
 		},
 		{
 			name:     "`to` greater than slice length is moved to end",
@@ -956,4 +1309,1500 @@ func TestPop(t *testing.T) {
 	}
 }
 
+func TestJoin(t *testing.T) {
+	type testCase struct {
+		name     string
+		payload  Slice[int]
+		sep      string
+		expected string
+	}
+
+	tests := []testCase{
+		{
+			name:     "nil slice yields empty string",
+			payload:  nil,
+			sep:      ", ",
+			expected: "",
+		},
+		{
+			name:     "empty slice yields empty string",
+			payload:  Slice[int]([]int{}),
+			sep:      ", ",
+			expected: "",
+		},
+		{
+			name:     "slice with one item has no separator",
+			payload:  Slice[int]([]int{1}),
+			sep:      ", ",
+			expected: "1",
+		},
+		{
+			name:     "slice with several items",
+			payload:  Slice[int]([]int{1, 2, 3}),
+			sep:      ", ",
+			expected: "1, 2, 3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := Join(test.payload, test.sep, strconv.Itoa)
+
+			if test.expected != actual {
+				t.Errorf("unexpected value, want %q, have %q", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestConcat(t *testing.T) {
+	var (
+		one   = []int{1, 2}
+		other = []int{3}
+		last  = []int{4, 5}
+	)
+
+	actual := Concat(one, nil, other, last)
+	expected := Slice[int]([]int{1, 2, 3, 4, 5})
+
+	if !expected.Equals(actual, testArrEq) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+
+	if cap(actual) != len(expected) {
+		t.Errorf("unexpected capacity, want %d, have %d", len(expected), cap(actual))
+	}
+
+	actual[0] = 100
+
+	if !Equals(one, []int{1, 2}, testArrEq) ||
+		!Equals(other, []int{3}, testArrEq) ||
+		!Equals(last, []int{4, 5}, testArrEq) {
+		t.Errorf("unexpected mutation of inputs, have %v, %v, %v", one, other, last)
+	}
+
+	if actual = Concat[int](); len(actual) != 0 {
+		t.Errorf("unexpected value, want empty slice, have %v", actual)
+	}
+}
+
+func TestFilterIndexed(t *testing.T) {
+	payload := []string{"a", "b", "c", "d", "e"}
+
+	actual := FilterIndexed(payload, func(_ string, i int) bool {
+		return i%2 == 0
+	})
+	expected := Slice[string]([]string{"a", "c", "e"})
+
+	if !expected.Equals(actual, func(x, y string) bool { return x == y }) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+
+	if actual = FilterIndexed(nil, func(string, int) bool { return true }); len(actual) != 0 {
+		t.Errorf("unexpected value, want empty slice, have %v", actual)
+	}
+}
+
+func TestMapIndexed(t *testing.T) {
+	payload := []string{"a", "b", "c"}
+
+	actual := MapIndexed(payload, func(x string, i int) string {
+		return strconv.Itoa(i) + ":" + x
+	})
+	expected := Slice[string]([]string{"0:a", "1:b", "2:c"})
+
+	if !expected.Equals(actual, func(x, y string) bool { return x == y }) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+}
+
+func TestFirstLast(t *testing.T) {
+	type testCase struct {
+		name          string
+		payload       Slice[int]
+		expectedFirst fp.Option[int]
+		expectedLast  fp.Option[int]
+	}
+
+	tests := []testCase{
+		{
+			name:          "nil slice yields none",
+			payload:       nil,
+			expectedFirst: fp.None[int](),
+			expectedLast:  fp.None[int](),
+		},
+		{
+			name:          "empty slice yields none",
+			payload:       Slice[int]([]int{}),
+			expectedFirst: fp.None[int](),
+			expectedLast:  fp.None[int](),
+		},
+		{
+			name:          "slice with one item",
+			payload:       Slice[int]([]int{1}),
+			expectedFirst: fp.Some(1),
+			expectedLast:  fp.Some(1),
+		},
+		{
+			name:          "slice with several items",
+			payload:       Slice[int]([]int{1, 2, 3}),
+			expectedFirst: fp.Some(1),
+			expectedLast:  fp.Some(3),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := test.payload.First(); test.expectedFirst != actual {
+				t.Errorf("unexpected first, want %v, have %v", test.expectedFirst, actual)
+			}
+			if actual := Last(test.payload); test.expectedLast != actual {
+				t.Errorf("unexpected last, want %v, have %v", test.expectedLast, actual)
+			}
+		})
+	}
+}
+
+func TestTailInit(t *testing.T) {
+	type testCase struct {
+		name         string
+		payload      Slice[int]
+		expectedTail []int
+		expectedInit []int
+	}
+
+	tests := []testCase{
+		{
+			name:         "nil slice yields empty",
+			payload:      nil,
+			expectedTail: []int{},
+			expectedInit: []int{},
+		},
+		{
+			name:         "empty slice yields empty",
+			payload:      Slice[int]([]int{}),
+			expectedTail: []int{},
+			expectedInit: []int{},
+		},
+		{
+			name:         "slice with one item yields empty",
+			payload:      Slice[int]([]int{1}),
+			expectedTail: []int{},
+			expectedInit: []int{},
+		},
+		{
+			name:         "slice with several items",
+			payload:      Slice[int]([]int{1, 2, 3}),
+			expectedTail: []int{2, 3},
+			expectedInit: []int{1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := test.payload.Tail(); !Equals(test.expectedTail, actual, testArrEq) {
+				t.Errorf("unexpected tail, want %v, have %v", test.expectedTail, actual)
+			}
+			if actual := Init(test.payload); !Equals(test.expectedInit, actual, testArrEq) {
+				t.Errorf("unexpected init, want %v, have %v", test.expectedInit, actual)
+			}
+		})
+	}
+}
+
+func TestCycle(t *testing.T) {
+	type testCase struct {
+		name     string
+		payload  []int
+		n        int
+		expected []int
+	}
+
+	tests := []testCase{
+		{
+			name:     "nil slice yields empty",
+			payload:  nil,
+			n:        3,
+			expected: []int{},
+		},
+		{
+			name:     "non positive length yields empty",
+			payload:  []int{1, 2},
+			n:        -1,
+			expected: []int{},
+		},
+		{
+			name:     "length less than slice",
+			payload:  []int{1, 2, 3},
+			n:        2,
+			expected: []int{1, 2},
+		},
+		{
+			name:     "length equal to slice",
+			payload:  []int{1, 2, 3},
+			n:        3,
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "length greater than slice",
+			payload:  []int{1, 2},
+			n:        5,
+			expected: []int{1, 2, 1, 2, 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := Cycle(test.payload, test.n)
+
+			if actual == nil || !Equals(test.expected, actual, testArrEq) {
+				t.Errorf("unexpected value, want %v, have %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	chunksEq := func(x, y []int) bool { return Equals(x, y, testArrEq) }
+
+	actual := Permutations([]int{1, 2, 3})
+	expected := [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}
+
+	if !Equals(expected, actual, chunksEq) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+
+	if actual := Permutations([]int{1, 2, 3, 4, 5}); len(actual) != 120 {
+		t.Errorf("unexpected permutation count, want 120, have %d", len(actual))
+	}
+
+	if actual := Permutations[int](nil); len(actual) != 1 || len(actual[0]) != 0 {
+		t.Errorf("unexpected value, want [[]], have %v", actual)
+	}
+
+	count := 0
+	for range PermutationsSeq([]int{1, 2, 3, 4}) {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+
+	if count != 3 {
+		t.Errorf("unexpected iterations, want 3, have %d", count)
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	type testCase struct {
+		name     string
+		payload  []int
+		k        int
+		expected int
+	}
+
+	tests := []testCase{
+		{name: "k of zero yields the empty combination", payload: []int{1, 2}, k: 0, expected: 1},
+		{name: "negative k yields none", payload: []int{1, 2}, k: -1, expected: 0},
+		{name: "k greater than length yields none", payload: []int{1, 2}, k: 3, expected: 0},
+		{name: "k equal to length", payload: []int{1, 2, 3}, k: 3, expected: 1},
+		{name: "C(5, 2)", payload: []int{1, 2, 3, 4, 5}, k: 2, expected: 10},
+		{name: "C(6, 3)", payload: []int{1, 2, 3, 4, 5, 6}, k: 3, expected: 20},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := Combinations(test.payload, test.k); len(actual) != test.expected {
+				t.Errorf("unexpected combination count, want %d, have %d",
+					test.expected, len(actual))
+			}
+		})
+	}
+
+	actual := Combinations([]int{1, 2, 3, 4}, 2)
+	expected := [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}
+
+	if !Equals(expected, actual, func(x, y []int) bool { return Equals(x, y, testArrEq) }) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+}
+
+func TestChunk(t *testing.T) {
+	type testCase struct {
+		name           string
+		payload        []int
+		size           int
+		expected       [][]int
+		expectedPadded [][]int
+	}
+
+	tests := []testCase{
+		{
+			name:           "nil slice yields no chunks",
+			payload:        nil,
+			size:           2,
+			expected:       [][]int{},
+			expectedPadded: [][]int{},
+		},
+		{
+			name:           "size lower than one yields a single chunk",
+			payload:        []int{1, 2, 3},
+			size:           0,
+			expected:       [][]int{{1, 2, 3}},
+			expectedPadded: [][]int{{1, 2, 3}},
+		},
+		{
+			name:           "evenly divided slice",
+			payload:        []int{1, 2, 3, 4},
+			size:           2,
+			expected:       [][]int{{1, 2}, {3, 4}},
+			expectedPadded: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name:           "unevenly divided slice",
+			payload:        []int{1, 2, 3, 4, 5},
+			size:           3,
+			expected:       [][]int{{1, 2, 3}, {4, 5}},
+			expectedPadded: [][]int{{1, 2, 3}, {4, 5, -1}},
+		},
+		{
+			name:           "size greater than slice",
+			payload:        []int{1},
+			size:           3,
+			expected:       [][]int{{1}},
+			expectedPadded: [][]int{{1, -1, -1}},
+		},
+	}
+
+	chunksEq := func(x, y []int) bool { return Equals(x, y, testArrEq) }
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := Chunk(test.payload, test.size); !Equals(test.expected, actual, chunksEq) {
+				t.Errorf("unexpected chunks, want %v, have %v", test.expected, actual)
+			}
+
+			padded := ChunkPadded(test.payload, test.size, -1)
+			if !Equals(test.expectedPadded, padded, chunksEq) {
+				t.Errorf("unexpected padded chunks, want %v, have %v", test.expectedPadded, padded)
+			}
+		})
+	}
+}
+
+func TestReshape(t *testing.T) {
+	type testCase struct {
+		name          string
+		payload       []int
+		cols          int
+		expected      [][]int
+		expectedExact error
+	}
+
+	tests := []testCase{
+		{
+			name:          "nil slice yields no rows",
+			payload:       nil,
+			cols:          3,
+			expected:      [][]int{},
+			expectedExact: nil,
+		},
+		{
+			name:          "exact division",
+			payload:       []int{1, 2, 3, 4, 5, 6},
+			cols:          3,
+			expected:      [][]int{{1, 2, 3}, {4, 5, 6}},
+			expectedExact: nil,
+		},
+		{
+			name:          "inexact division leaves a short last row",
+			payload:       []int{1, 2, 3, 4, 5},
+			cols:          2,
+			expected:      [][]int{{1, 2}, {3, 4}, {5}},
+			expectedExact: ErrShape,
+		},
+		{
+			name:          "non positive columns",
+			payload:       []int{1, 2},
+			cols:          0,
+			expected:      [][]int{{1, 2}},
+			expectedExact: ErrShape,
+		},
+	}
+
+	rowsEq := func(x, y []int) bool { return Equals(x, y, testArrEq) }
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := Reshape(test.payload, test.cols); !Equals(test.expected, actual, rowsEq) {
+				t.Errorf("unexpected rows, want %v, have %v", test.expected, actual)
+			}
+
+			actual, err := ReshapeExact(test.payload, test.cols)
+			if !errors.Is(err, test.expectedExact) {
+				t.Errorf("unexpected err, want %v, have %v", test.expectedExact, err)
+			}
+
+			if err == nil && !Equals(test.expected, actual, rowsEq) {
+				t.Errorf("unexpected exact rows, want %v, have %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestChunkBy(t *testing.T) {
+	type testCase struct {
+		name     string
+		payload  []int
+		expected [][]int
+	}
+
+	tests := []testCase{
+		{
+			name:     "nil slice yields no chunks",
+			payload:  nil,
+			expected: nil,
+		},
+		{
+			name:     "slice with one item yields one chunk",
+			payload:  []int{1},
+			expected: [][]int{{1}},
+		},
+		{
+			name:     "runs are collapsed",
+			payload:  []int{1, 1, 2, 3, 3},
+			expected: [][]int{{1, 1}, {2}, {3, 3}},
+		},
+		{
+			name:     "non consecutive equal keys are kept apart",
+			payload:  []int{1, 2, 1},
+			expected: [][]int{{1}, {2}, {1}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := ChunkBy(test.payload, func(x int) int { return x })
+
+			if !Equals(test.expected, actual, func(x, y []int) bool {
+				return Equals(x, y, testArrEq)
+			}) {
+				t.Errorf("unexpected value, want %v, have %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIndexOfSubslice(t *testing.T) {
+	type testCase struct {
+		name     string
+		haystack []int
+		needle   []int
+		expected int
+	}
+
+	tests := []testCase{
+		{
+			name:     "empty needle is found at zero",
+			haystack: []int{1, 2, 3},
+			needle:   nil,
+			expected: 0,
+		},
+		{
+			name:     "empty needle in nil haystack is found at zero",
+			haystack: nil,
+			needle:   []int{},
+			expected: 0,
+		},
+		{
+			name:     "needle at start",
+			haystack: []int{1, 2, 3, 4},
+			needle:   []int{1, 2},
+			expected: 0,
+		},
+		{
+			name:     "needle in the middle",
+			haystack: []int{1, 2, 3, 4},
+			needle:   []int{2, 3},
+			expected: 1,
+		},
+		{
+			name:     "needle at end",
+			haystack: []int{1, 2, 3, 4},
+			needle:   []int{3, 4},
+			expected: 2,
+		},
+		{
+			name:     "needle after a partial match",
+			haystack: []int{1, 1, 2},
+			needle:   []int{1, 2},
+			expected: 1,
+		},
+		{
+			name:     "absent needle",
+			haystack: []int{1, 2, 3, 4},
+			needle:   []int{2, 4},
+			expected: -1,
+		},
+		{
+			name:     "needle longer than haystack",
+			haystack: []int{1},
+			needle:   []int{1, 2},
+			expected: -1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := IndexOfSubslice(test.haystack, test.needle)
+
+			if test.expected != actual {
+				t.Errorf("unexpected index, want %d, have %d", test.expected, actual)
+			}
+
+			contains := ContainsSubslice(test.haystack, test.needle)
+			if contains != (test.expected >= 0) {
+				t.Errorf("unexpected contains, want %t, have %t", test.expected >= 0, contains)
+			}
+		})
+	}
+}
+
+func TestMaxMinIndex(t *testing.T) {
+	type testCase struct {
+		name        string
+		payload     []int
+		expectedMax int
+		expectedMin int
+	}
+
+	tests := []testCase{
+		{
+			name:        "nil slice yields -1",
+			payload:     nil,
+			expectedMax: -1,
+			expectedMin: -1,
+		},
+		{
+			name:        "slice with one item",
+			payload:     []int{1},
+			expectedMax: 0,
+			expectedMin: 0,
+		},
+		{
+			name:        "slice with several items",
+			payload:     []int{2, 5, 1, 3},
+			expectedMax: 1,
+			expectedMin: 2,
+		},
+		{
+			name:        "first position wins on ties",
+			payload:     []int{1, 5, 1, 5},
+			expectedMax: 1,
+			expectedMin: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := MaxIndex(test.payload); test.expectedMax != actual {
+				t.Errorf("unexpected max index, want %d, have %d", test.expectedMax, actual)
+			}
+			if actual := MinIndex(test.payload); test.expectedMin != actual {
+				t.Errorf("unexpected min index, want %d, have %d", test.expectedMin, actual)
+			}
+		})
+	}
+}
+
+func TestMaxIndexBy(t *testing.T) {
+	type point struct{ x, y int }
+
+	payload := []point{{1, 2}, {3, 1}, {3, 5}, {0, 0}}
+	less := func(a, b point) bool { return a.x < b.x }
+
+	if actual := MaxIndexBy(payload, less); actual != 1 {
+		t.Errorf("unexpected max index, want %d, have %d", 1, actual)
+	}
+
+	if actual := MinIndexBy(payload, less); actual != 3 {
+		t.Errorf("unexpected min index, want %d, have %d", 3, actual)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	actual := FromSeq(fp.Some(1).Iter())
+
+	if !Equals(actual, []int{1}, testArrEq) {
+		t.Errorf("unexpected value, want %v, have %v", []int{1}, actual)
+	}
+
+	actual = FromSeq(fp.None[int]().Iter())
+
+	if actual == nil || len(actual) != 0 {
+		t.Errorf("unexpected value, want empty slice, have %v", actual)
+	}
+}
+
+func TestToFromChannel(t *testing.T) {
+	payload := []int{1, 2, 3}
+
+	actual := FromChannel(ToChannel(payload))
+
+	if !Equals(actual, payload, testArrEq) {
+		t.Errorf("unexpected value, want %v, have %v", payload, actual)
+	}
+
+	if actual := FromChannel(ToChannel[int](nil)); actual == nil || len(actual) != 0 {
+		t.Errorf("unexpected value, want empty slice, have %v", actual)
+	}
+
+	ch := make(chan int)
+	go func() {
+		for _, x := range payload {
+			ch <- x
+		}
+		close(ch)
+	}()
+
+	if actual := FromChannel(ch); !Equals(actual, payload, testArrEq) {
+		t.Errorf("unexpected value, want %v, have %v", payload, actual)
+	}
+}
+
+func TestClone(t *testing.T) {
+	if Clone[int](nil) != nil {
+		t.Errorf("unexpected value, want nil")
+	}
+
+	if Slice[int](nil).Clone() != nil {
+		t.Errorf("unexpected value, want nil")
+	}
+
+	empty := Clone([]int{})
+	if empty == nil || len(empty) != 0 {
+		t.Errorf("unexpected value, want empty non nil slice, have %v", empty)
+	}
+
+	payload := []int{1, 2}
+	actual := Clone(payload)
+	actual[0] = 100
+
+	if payload[0] != 1 || !Equals(actual, []int{100, 2}, testArrEq) {
+		t.Errorf("unexpected value, have %v and %v", payload, actual)
+	}
+}
+
+func TestCloneFunc(t *testing.T) {
+	payload := [][]int{{1, 2}, {3}}
+
+	actual := CloneFunc(payload, func(x []int) []int {
+		return Slice[int](x).Clone()
+	})
+
+	actual[0][0] = 100
+	actual[1] = append(actual[1], 4)
+
+	if payload[0][0] != 1 || len(payload[1]) != 1 {
+		t.Errorf("unexpected mutation of input, have %v", payload)
+	}
+
+	if actual[0][0] != 100 || len(actual[1]) != 2 {
+		t.Errorf("unexpected value, have %v", actual)
+	}
+
+	if CloneFunc[int](nil, func(x int) int { return x }) != nil {
+		t.Errorf("unexpected value, want nil")
+	}
+}
+
+func TestMapInto(t *testing.T) {
+	buf := make([]string, 1, 4)
+	buf[0] = "stale"
+
+	actual := MapInto(buf, []int{1, 2, 3}, strconv.Itoa)
+	expected := []string{"1", "2", "3"}
+
+	if !Equals(expected, actual, func(x, y string) bool { return x == y }) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+
+	if &actual[0] != &buf[0] {
+		t.Errorf("unexpected allocation, buffer with enough capacity should be reused")
+	}
+
+	if actual = MapInto(nil, []int{1}, strconv.Itoa); len(actual) != 1 || actual[0] != "1" {
+		t.Errorf("unexpected value, want [1], have %v", actual)
+	}
+}
+
+func BenchmarkMap(b *testing.B) {
+	payload := benchmarkPayload()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Map(payload, func(x int) int64 { return int64(x) * 2 })
+	}
+}
+
+func BenchmarkMapInto(b *testing.B) {
+	payload := benchmarkPayload()
+	buf := make([]int64, 0, len(payload))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = MapInto(buf, payload, func(x int) int64 { return int64(x) * 2 })
+	}
+}
+
+func TestLazy(t *testing.T) {
+	calls := 0
+	seq := MapLazy(
+		FilterLazy(ToSeq([]int{1, 2, 3, 4, 5, 6}), func(x int) bool {
+			calls++
+			return x%2 == 0
+		}),
+		strconv.Itoa,
+	)
+
+	if calls != 0 {
+		t.Errorf("unexpected predicate calls before consuming, want 0, have %d", calls)
+	}
+
+	actual := CollectCap(seq, 3)
+	expected := []string{"2", "4", "6"}
+
+	if !Equals(actual, expected, func(x, y string) bool { return x == y }) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+
+	for range seq {
+		break
+	}
+
+	if calls != 8 {
+		t.Errorf("unexpected predicate calls, want 8, have %d", calls)
+	}
+
+	odds := FromSeq(FilterMapLazy(ToSeq([]int{1, 2, 3}), func(x int) fp.Option[string] {
+		if x%2 == 0 {
+			return fp.None[string]()
+		}
+		return fp.Some(strconv.Itoa(x))
+	}))
+
+	if !Equals(odds, []string{"1", "3"}, func(x, y string) bool { return x == y }) {
+		t.Errorf("unexpected value, want %v, have %v", []string{"1", "3"}, odds)
+	}
+}
+
+func benchmarkPayload() []int {
+	res := make([]int, 100_000)
+	for i := range res {
+		res[i] = i
+	}
+	return res
+}
+
+func BenchmarkFilterMap_Eager(b *testing.B) {
+	payload := benchmarkPayload()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Map(Filter(payload, func(x int) bool { return x%2 == 0 }), func(x int) int64 {
+			return int64(x) * 2
+		})
+	}
+}
+
+func BenchmarkFilterMap_Lazy(b *testing.B) {
+	payload := benchmarkPayload()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = CollectCap(MapLazy(FilterLazy(ToSeq(payload), func(x int) bool { return x%2 == 0 }),
+			func(x int) int64 { return int64(x) * 2 },
+		), len(payload)/2)
+	}
+}
+
+func TestCollectMap(t *testing.T) {
+	var seq iter.Seq2[string, int] = func(yield func(string, int) bool) {
+		_ = yield("a", 1) && yield("b", 2) && yield("a", 3)
+	}
+
+	actual := CollectMap(seq)
+
+	if len(actual) != 2 || actual["a"] != 3 || actual["b"] != 2 {
+		t.Errorf("unexpected value, want map[a:3 b:2], have %v", actual)
+	}
+
+	if actual = CollectMap[string, int](nil); actual == nil || len(actual) != 0 {
+		t.Errorf("unexpected value, want empty map, have %v", actual)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	actual := GroupBy([]string{"a", "bb", "c", "dd", "eee"}, func(x string) int { return len(x) })
+
+	eq := func(x, y string) bool { return x == y }
+
+	if len(actual) != 3 ||
+		!Equals(actual[1], []string{"a", "c"}, eq) ||
+		!Equals(actual[2], []string{"bb", "dd"}, eq) ||
+		!Equals(actual[3], []string{"eee"}, eq) {
+		t.Errorf("unexpected value, have %v", actual)
+	}
+
+	if empty := GroupBy(nil, func(x int) int { return x }); empty == nil || len(empty) != 0 {
+		t.Errorf("unexpected value, want empty map, have %v", empty)
+	}
+}
+
+func TestGroupReduce(t *testing.T) {
+	type sale struct {
+		region string
+		amount int
+	}
+
+	payload := []sale{{"north", 10}, {"south", 5}, {"north", 7}, {"east", 1}, {"south", 3}}
+	region := func(s sale) string { return s.region }
+	sum := func(acc int, s sale) int { return acc + s.amount }
+
+	actual := GroupReduce(payload, region, sum, 100)
+
+	groups := GroupBy(payload, region)
+	if len(actual) != len(groups) {
+		t.Errorf("unexpected groups, want %d, have %d", len(groups), len(actual))
+	}
+
+	for k, group := range groups {
+		if expected := Fold(group, sum, 100); actual[k] != expected {
+			t.Errorf("unexpected value for %s, want %d, have %d", k, expected, actual[k])
+		}
+	}
+
+	if actual["north"] != 117 {
+		t.Errorf("unexpected value, want 117, have %d", actual["north"])
+	}
+
+	if empty := GroupReduce(nil, region, sum, 0); empty == nil || len(empty) != 0 {
+		t.Errorf("unexpected value, want empty map, have %v", empty)
+	}
+}
+
+func TestGroupBySeq(t *testing.T) {
+	var seq iter.Seq[int] = func(yield func(int) bool) {
+		for i := 1; i <= 5; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	actual := GroupBySeq(seq, func(x int) bool { return x%2 == 0 })
+
+	if len(actual) != 2 ||
+		!Equals(actual[true], []int{2, 4}, testArrEq) ||
+		!Equals(actual[false], []int{1, 3, 5}, testArrEq) {
+		t.Errorf("unexpected value, have %v", actual)
+	}
+
+	if empty := GroupBySeq[int, int](nil, func(x int) int { return x }); len(empty) != 0 {
+		t.Errorf("unexpected value, want empty map, have %v", empty)
+	}
+}
+
+func TestFrequency(t *testing.T) {
+	actual := Frequency([]string{"a", "b", "a", "c", "a", "b"})
+
+	if len(actual) != 3 || actual["a"] != 3 || actual["b"] != 2 || actual["c"] != 1 {
+		t.Errorf("unexpected value, want map[a:3 b:2 c:1], have %v", actual)
+	}
+
+	if empty := Frequency[int](nil); empty == nil || len(empty) != 0 {
+		t.Errorf("unexpected value, want empty map, have %v", empty)
+	}
+}
+
+func TestTopK(t *testing.T) {
+	type testCase struct {
+		name     string
+		payload  []string
+		k        int
+		expected []tuples.Tuple2[string, int]
+	}
+
+	tests := []testCase{
+		{
+			name:     "nil slice yields empty",
+			payload:  nil,
+			k:        2,
+			expected: []tuples.Tuple2[string, int]{},
+		},
+		{
+			name:    "sorted by count, ties by first appearance",
+			payload: []string{"a", "b", "c", "b", "c", "c", "d", "a"},
+			k:       3,
+			expected: []tuples.Tuple2[string, int]{
+				{V1: "c", V2: 3},
+				{V1: "a", V2: 2},
+				{V1: "b", V2: 2},
+			},
+		},
+		{
+			name:    "k exceeding distinct elements returns all",
+			payload: []string{"a", "b", "b"},
+			k:       5,
+			expected: []tuples.Tuple2[string, int]{
+				{V1: "b", V2: 2},
+				{V1: "a", V2: 1},
+			},
+		},
+		{
+			name:     "zero k yields empty",
+			payload:  []string{"a", "b", "b"},
+			k:        0,
+			expected: []tuples.Tuple2[string, int]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := TopK(test.payload, test.k)
+
+			eq := func(x, y tuples.Tuple2[string, int]) bool { return x == y }
+
+			if !Equals(test.expected, actual, eq) {
+				t.Errorf("unexpected value, want %v, have %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSplitAt(t *testing.T) {
+	type testCase struct {
+		name           string
+		payload        []int
+		idx            int
+		expectedPrefix []int
+		expectedSuffix []int
+	}
+
+	tests := []testCase{
+		{
+			name:           "split at zero",
+			payload:        []int{1, 2, 3},
+			idx:            0,
+			expectedPrefix: []int{},
+			expectedSuffix: []int{1, 2, 3},
+		},
+		{
+			name:           "split in the middle",
+			payload:        []int{1, 2, 3},
+			idx:            1,
+			expectedPrefix: []int{1},
+			expectedSuffix: []int{2, 3},
+		},
+		{
+			name:           "split at length",
+			payload:        []int{1, 2, 3},
+			idx:            3,
+			expectedPrefix: []int{1, 2, 3},
+			expectedSuffix: []int{},
+		},
+		{
+			name:           "split out of bounds from the right is clamped",
+			payload:        []int{1, 2, 3},
+			idx:            5,
+			expectedPrefix: []int{1, 2, 3},
+			expectedSuffix: []int{},
+		},
+		{
+			name:           "split out of bounds from the left is clamped",
+			payload:        []int{1, 2, 3},
+			idx:            -1,
+			expectedPrefix: []int{},
+			expectedSuffix: []int{1, 2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			prefix, suffix := SplitAt(test.payload, test.idx)
+
+			if !Equals(test.expectedPrefix, prefix, testArrEq) ||
+				!Equals(test.expectedSuffix, suffix, testArrEq) {
+				t.Errorf("unexpected value, want %v %v, have %v %v",
+					test.expectedPrefix, test.expectedSuffix, prefix, suffix)
+			}
+		})
+	}
+}
+
+func TestSplitWhen(t *testing.T) {
+	type testCase struct {
+		name     string
+		payload  []int
+		expected [][]int
+	}
+
+	tests := []testCase{
+		{
+			name:     "nil slice yields no groups",
+			payload:  nil,
+			expected: nil,
+		},
+		{
+			name:     "no matches yield a single group",
+			payload:  []int{1, 2, 3},
+			expected: [][]int{{1, 2, 3}},
+		},
+		{
+			name:     "matches open new groups",
+			payload:  []int{1, 0, 2, 0, 3},
+			expected: [][]int{{1}, {0, 2}, {0, 3}},
+		},
+		{
+			name:     "match at the head does not yield an empty group",
+			payload:  []int{0, 1, 0},
+			expected: [][]int{{0, 1}, {0}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := SplitWhen(test.payload, func(x int) bool { return x == 0 })
+
+			if !Equals(test.expected, actual, func(x, y []int) bool {
+				return Equals(x, y, testArrEq)
+			}) {
+				t.Errorf("unexpected value, want %v, have %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestBatch(t *testing.T) {
+	type testCase struct {
+		name     string
+		payload  []int
+		size     int
+		expected [][]int
+	}
+
+	tests := []testCase{
+		{
+			name:     "nil slice yields no batches",
+			payload:  nil,
+			size:     2,
+			expected: [][]int{},
+		},
+		{
+			name:     "exact multiple",
+			payload:  []int{1, 2, 3, 4},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name:     "remainder batch",
+			payload:  []int{1, 2, 3, 4, 5},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name:     "size greater than length",
+			payload:  []int{1, 2},
+			size:     5,
+			expected: [][]int{{1, 2}},
+		},
+		{
+			name:     "invalid size yields a single batch",
+			payload:  []int{1, 2},
+			size:     0,
+			expected: [][]int{{1, 2}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := make([][]int, 0)
+
+			err := Batch(test.payload, test.size, func(batch []int) error {
+				actual = append(actual, batch)
+				return nil
+			})
+
+			if err != nil {
+				t.Errorf("unexpected error, have %v", err)
+			}
+
+			if !Equals(test.expected, actual, func(x, y []int) bool {
+				return Equals(x, y, testArrEq)
+			}) {
+				t.Errorf("unexpected batches, want %v, have %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestBatch_Error(t *testing.T) {
+	fail := errors.New("cannot send batch")
+	calls := 0
+
+	err := Batch([]int{1, 2, 3, 4, 5}, 2, func(batch []int) error {
+		calls++
+		if batch[0] == 3 {
+			return fail
+		}
+		return nil
+	})
+
+	if !errors.Is(err, fail) {
+		t.Errorf("unexpected error, want %v, have %v", fail, err)
+	}
+
+	if calls != 2 {
+		t.Errorf("unexpected calls, want 2, have %d", calls)
+	}
+}
+
+func TestCopyInto(t *testing.T) {
+	type testCase struct {
+		name     string
+		dst      []int
+		src      []int
+		at       int
+		expected []int
+		copied   int
+	}
+
+	tests := []testCase{
+		{
+			name:     "copy at the head",
+			dst:      []int{0, 0, 0, 0},
+			src:      []int{1, 2},
+			at:       0,
+			expected: []int{1, 2, 0, 0},
+			copied:   2,
+		},
+		{
+			name:     "partial copy at the tail",
+			dst:      []int{0, 0, 0, 0},
+			src:      []int{1, 2, 3},
+			at:       2,
+			expected: []int{0, 0, 1, 2},
+			copied:   2,
+		},
+		{
+			name:     "over long src is truncated",
+			dst:      []int{0, 0},
+			src:      []int{1, 2, 3, 4},
+			at:       0,
+			expected: []int{1, 2},
+			copied:   2,
+		},
+		{
+			name:     "negative position is noop",
+			dst:      []int{0, 0},
+			src:      []int{1},
+			at:       -1,
+			expected: []int{0, 0},
+			copied:   0,
+		},
+		{
+			name:     "out of bounds position is noop",
+			dst:      []int{0, 0},
+			src:      []int{1},
+			at:       2,
+			expected: []int{0, 0},
+			copied:   0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			copied := CopyInto(test.dst, test.src, test.at)
+
+			if test.copied != copied {
+				t.Errorf("unexpected copied amount, want %d, have %d", test.copied, copied)
+			}
+
+			if !Equals(test.expected, test.dst, testArrEq) {
+				t.Errorf("unexpected value, want %v, have %v", test.expected, test.dst)
+			}
+		})
+	}
+}
+
+func TestRemove(t *testing.T) {
+	type testCase struct {
+		name          string
+		payload       []int
+		target        int
+		expectedFirst []int
+		expectedAll   []int
+	}
+
+	tests := []testCase{
+		{
+			name:          "nil slice is noop",
+			payload:       nil,
+			target:        1,
+			expectedFirst: nil,
+			expectedAll:   nil,
+		},
+		{
+			name:          "target present once",
+			payload:       []int{1, 2, 3},
+			target:        2,
+			expectedFirst: []int{1, 3},
+			expectedAll:   []int{1, 3},
+		},
+		{
+			name:          "target present several times",
+			payload:       []int{2, 1, 2, 3, 2},
+			target:        2,
+			expectedFirst: []int{1, 2, 3, 2},
+			expectedAll:   []int{1, 3},
+		},
+		{
+			name:          "target absent",
+			payload:       []int{1, 3},
+			target:        2,
+			expectedFirst: []int{1, 3},
+			expectedAll:   []int{1, 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			first := RemoveFirst(Clone(test.payload), test.target)
+			if !Equals(test.expectedFirst, first, testArrEq) {
+				t.Errorf("unexpected RemoveFirst value, want %v, have %v",
+					test.expectedFirst, first)
+			}
+
+			all := RemoveAll(Clone(test.payload), test.target)
+			if !Equals(test.expectedAll, all, testArrEq) {
+				t.Errorf("unexpected RemoveAll value, want %v, have %v", test.expectedAll, all)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	evens := func(arr []int) []int {
+		return Filter(arr, func(x int) bool { return x%2 == 0 })
+	}
+	double := func(arr []int) []int {
+		return Map(arr, func(x int) int { return x * 2 })
+	}
+
+	actual := Apply([]int{1, 2, 3, 4}, evens, double)
+	expected := []int{4, 8}
+
+	if !Equals(expected, actual, testArrEq) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+
+	if actual = Apply([]int{1, 2}); !Equals([]int{1, 2}, actual, testArrEq) {
+		t.Errorf("unexpected value, want input unchanged, have %v", actual)
+	}
+}
+
+func TestWindowReduce(t *testing.T) {
+	type testCase struct {
+		name     string
+		payload  []int
+		size     int
+		expected []int
+	}
+
+	tests := []testCase{
+		{
+			name:     "moving sum",
+			payload:  []int{1, 2, 3, 4},
+			size:     2,
+			expected: []int{3, 5, 7},
+		},
+		{
+			name:     "window as long as the slice",
+			payload:  []int{1, 2, 3, 4},
+			size:     4,
+			expected: []int{10},
+		},
+		{
+			name:     "window longer than the slice",
+			payload:  []int{1, 2},
+			size:     3,
+			expected: []int{},
+		},
+		{
+			name:     "invalid window size",
+			payload:  []int{1, 2},
+			size:     0,
+			expected: []int{},
+		},
+	}
+
+	sum := func(window []int) int {
+		return ReduceSame(window, func(x, y int) int { return x + y })
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := WindowReduce(test.payload, test.size, sum)
+
+			if !Equals(test.expected, actual, testArrEq) {
+				t.Errorf("unexpected value, want %v, have %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWindowReduce_Copies(t *testing.T) {
+	payload := []int{1, 2, 3}
+
+	_ = WindowReduce(payload, 2, func(window []int) int {
+		window[0] = 100
+		return 0
+	})
+
+	if !Equals(payload, []int{1, 2, 3}, testArrEq) {
+		t.Errorf("unexpected mutation of input, have %v", payload)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	actual := Flatten([][]int{{1, 2}, nil, {3}})
+	expected := []int{1, 2, 3}
+
+	if !Equals(expected, actual, testArrEq) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+}
+
+func TestFlattenDeep(t *testing.T) {
+	type testCase struct {
+		name     string
+		payload  []any
+		expected []any
+	}
+
+	tests := []testCase{
+		{
+			name:     "nil slice yields empty slice",
+			payload:  nil,
+			expected: []any{},
+		},
+		{
+			name:     "flat slice is kept",
+			payload:  []any{1, "2"},
+			expected: []any{1, "2"},
+		},
+		{
+			name:     "mixed nesting depths",
+			payload:  []any{1, []any{2, []any{3, []any{}}}, "4", []any{[]any{[]any{5}}}},
+			expected: []any{1, 2, 3, "4", 5},
+		},
+		{
+			name:     "typed slices are leaves",
+			payload:  []any{[]int{1, 2}, []any{3}},
+			expected: []any{[]int{1, 2}, 3},
+		},
+	}
+
+	eq := func(x, y any) bool { return fmt.Sprint(x) == fmt.Sprint(y) }
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := FlattenDeep(test.payload)
+
+			if !Equals(test.expected, actual, eq) {
+				t.Errorf("unexpected value, want %v, have %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPartition(t *testing.T) {
+	matched, unmatched := Partition([]int{1, 2, 3, 4, 5}, func(x int) bool { return x%2 == 1 })
+
+	if !Equals(matched, []int{1, 3, 5}, testArrEq) || !Equals(unmatched, []int{2, 4}, testArrEq) {
+		t.Errorf("unexpected value, want [1 3 5] [2 4], have %v %v", matched, unmatched)
+	}
+}
+
+func TestStablePartitionInPlace(t *testing.T) {
+	type testCase struct {
+		name          string
+		payload       []int
+		expected      []int
+		expectedPivot int
+	}
+
+	tests := []testCase{
+		{
+			name:          "nil slice",
+			payload:       nil,
+			expected:      nil,
+			expectedPivot: 0,
+		},
+		{
+			name:          "mixed elements",
+			payload:       []int{1, 2, 3, 4, 5},
+			expected:      []int{1, 3, 5, 2, 4},
+			expectedPivot: 3,
+		},
+		{
+			name:          "order is preserved within both halves",
+			payload:       []int{8, 7, 6, 5, 4, 3, 2, 1, 0},
+			expected:      []int{7, 5, 3, 1, 8, 6, 4, 2, 0},
+			expectedPivot: 4,
+		},
+		{
+			name:          "no element matches",
+			payload:       []int{2, 4},
+			expected:      []int{2, 4},
+			expectedPivot: 0,
+		},
+		{
+			name:          "every element matches",
+			payload:       []int{3, 1},
+			expected:      []int{3, 1},
+			expectedPivot: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pivot := StablePartitionInPlace(test.payload, func(x int) bool { return x%2 == 1 })
+
+			if test.expectedPivot != pivot {
+				t.Errorf("unexpected pivot, want %d, have %d", test.expectedPivot, pivot)
+			}
+
+			if !Equals(test.expected, test.payload, testArrEq) {
+				t.Errorf("unexpected value, want %v, have %v", test.expected, test.payload)
+			}
+		})
+	}
+}
+
+func TestCompactOptions(t *testing.T) {
+	actual := CompactOptions([]fp.Option[int]{
+		fp.None[int](), fp.Some(1), fp.Some(2), fp.None[int](), fp.Some(3),
+	})
+	expected := []int{1, 2, 3}
+
+	if !Equals(expected, actual, testArrEq) {
+		t.Errorf("unexpected value, want %v, have %v", expected, actual)
+	}
+
+	if actual = CompactOptions[int](nil); actual == nil || len(actual) != 0 {
+		t.Errorf("unexpected value, want empty slice, have %v", actual)
+	}
+}
+
 func testArrEq(x, y int) bool { return x == y }